@@ -25,6 +25,8 @@ var (
 	codeErrUpdateWorkerFailed    = NewCode("10104", "update worker failed")
 	codeErrReadDirectoryFailed   = NewCode("10105", "read directory failed")
 	codeErrReadFileFailed        = NewCode("10106", "read file failed")
+	codeErrNoAvailableWorkerPort = NewCode("10107", "no available worker port")
+	codeErrGraphNotFound         = NewCode("10108", "graph not found")
 )
 
 func NewCode(code string, msg string) *Code {
@@ -12,4 +12,22 @@ var (
 		SetRetryCount(0).
 		SetTimeout(5 * time.Second).
 		SetTLSClientConfig(&tls.Config{InsecureSkipVerify: true})
+
+	// workerUpdateClient is used for property-update posts to workers. It has
+	// its own timeout so a hung worker cannot stall the updater; see
+	// SetWorkerUpdateTimeout.
+	workerUpdateClient = resty.New().
+		SetRetryCount(0).
+		SetTimeout(defaultWorkerUpdateTimeout).
+		SetTLSClientConfig(&tls.Config{InsecureSkipVerify: true})
 )
+
+const defaultWorkerUpdateTimeout = 5 * time.Second
+
+// SetWorkerUpdateTimeout overrides the deadline applied to property-update
+// posts to workers. Non-positive values keep the default.
+func SetWorkerUpdateTimeout(d time.Duration) {
+	if d > 0 {
+		workerUpdateClient.SetTimeout(d)
+	}
+}
@@ -40,6 +40,19 @@ type HttpServerConfig struct {
 	WorkersMax               int
 	WorkerQuitTimeoutSeconds int
 	TenappDir                string
+
+	// Deadline for property-update posts to a worker; zero keeps the
+	// default. See SetWorkerUpdateTimeout.
+	WorkerUpdateTimeoutSeconds int
+
+	// Optional per-worker resource limits; zero values leave workers
+	// unconstrained.
+	WorkerMemoryLimitMB int
+	WorkerCgroupDir     string
+
+	// WorkerLogSampleEvery enables identical-line log sampling for worker
+	// output when positive; zero keeps every line. See SamplingWriter.
+	WorkerLogSampleEvery int
 }
 
 type PingReq struct {
@@ -58,6 +71,7 @@ type StartReq struct {
 	Properties           map[string]map[string]interface{} `json:"properties,omitempty"`
 	QuitTimeoutSeconds   int                               `json:"timeout,omitempty"`
 	TenappDir            string                            `json:"tenapp_dir,omitempty"` // IGNORED for security - always uses launch tenapp_dir
+	Replace              bool                              `json:"replace,omitempty"`    // Stop and replace an existing worker on the same channel
 }
 
 type StopReq struct {
@@ -65,6 +79,12 @@ type StopReq struct {
 	ChannelName string `json:"channel_name,omitempty"`
 }
 
+type UpdateTimeoutReq struct {
+	RequestId          string `json:"request_id,omitempty"`
+	ChannelName        string `json:"channel_name,omitempty"`
+	QuitTimeoutSeconds int    `json:"timeout,omitempty"`
+}
+
 type GenerateTokenReq struct {
 	RequestId   string `json:"request_id,omitempty"`
 	ChannelName string `json:"channel_name,omitempty"`
@@ -85,6 +105,10 @@ type VectorDocumentUpload struct {
 }
 
 func NewHttpServer(httpServerConfig *HttpServerConfig) *HttpServer {
+	if httpServerConfig.WorkerUpdateTimeoutSeconds > 0 {
+		SetWorkerUpdateTimeout(time.Duration(httpServerConfig.WorkerUpdateTimeoutSeconds) * time.Second)
+	}
+
 	return &HttpServer{
 		config: httpServerConfig,
 	}
@@ -113,12 +137,12 @@ func (s *HttpServer) handlerList(c *gin.Context) {
 
 func (s *HttpServer) handleGraphs(c *gin.Context) {
 	// read the property.json file and get the graph list from predefined_graphs, return the result as response
-    // for every graph object returned, only keep the name and auto_start fields
-    // Read property.json from tenapp_dir
-    propertyJsonPath := filepath.Join(s.config.TenappDir, "property.json")
-    content, err := os.ReadFile(propertyJsonPath)
+	// for every graph object returned, only keep the name and auto_start fields
+	// Read property.json from tenapp_dir
+	propertyJsonPath := filepath.Join(s.config.TenappDir, "property.json")
+	content, err := os.ReadFile(propertyJsonPath)
 	if err != nil {
-        slog.Error("failed to read property.json file", "err", err, "path", propertyJsonPath, logTag)
+		slog.Error("failed to read property.json file", "err", err, "path", propertyJsonPath, logTag)
 		s.output(c, codeErrReadFileFailed, http.StatusInternalServerError)
 		return
 	}
@@ -161,6 +185,45 @@ func (s *HttpServer) handleGraphs(c *gin.Context) {
 	s.output(c, codeSuccess, graphs)
 }
 
+// predefinedGraphNames reads property.json and returns the names of the
+// app's predefined graphs, so a requested graph name can be validated before
+// a worker is launched for it.
+func (s *HttpServer) predefinedGraphNames() (map[string]struct{}, error) {
+	propertyJsonPath := filepath.Join(s.config.TenappDir, "property.json")
+	content, err := os.ReadFile(propertyJsonPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var propertyJson map[string]interface{}
+	if err := json.Unmarshal(content, &propertyJson); err != nil {
+		return nil, err
+	}
+
+	tenSection, ok := propertyJson["ten"].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("invalid format: ten section missing")
+	}
+
+	predefinedGraphs, ok := tenSection["predefined_graphs"].([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("invalid format: predefined_graphs missing or not an array")
+	}
+
+	names := make(map[string]struct{}, len(predefinedGraphs))
+	for _, graph := range predefinedGraphs {
+		graphMap, ok := graph.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if name, ok := graphMap["name"].(string); ok {
+			names[name] = struct{}{}
+		}
+	}
+
+	return names, nil
+}
+
 func (s *HttpServer) handleAddonDefaultProperties(c *gin.Context) {
 	// Get the base directory path
 	baseDir := "./agents/ten_packages/extension"
@@ -233,6 +296,44 @@ func (s *HttpServer) handlerPing(c *gin.Context) {
 	s.output(c, codeSuccess, nil)
 }
 
+func (s *HttpServer) handlerUpdateTimeout(c *gin.Context) {
+	var req UpdateTimeoutReq
+
+	if err := c.ShouldBindBodyWith(&req, binding.JSON); err != nil {
+		slog.Error("handlerUpdateTimeout params invalid", "err", err, logTag)
+		s.output(c, codeErrParamsInvalid, http.StatusBadRequest)
+		return
+	}
+
+	slog.Info("handlerUpdateTimeout start", "channelName", req.ChannelName, "timeout", req.QuitTimeoutSeconds, "requestId", req.RequestId, logTag)
+
+	if strings.TrimSpace(req.ChannelName) == "" {
+		slog.Error("handlerUpdateTimeout channel empty", "channelName", req.ChannelName, "requestId", req.RequestId, logTag)
+		s.output(c, codeErrChannelEmpty, http.StatusBadRequest)
+		return
+	}
+
+	if req.QuitTimeoutSeconds <= 0 && req.QuitTimeoutSeconds != WORKER_TIMEOUT_INFINITY {
+		slog.Error("handlerUpdateTimeout timeout invalid", "timeout", req.QuitTimeoutSeconds, "requestId", req.RequestId, logTag)
+		s.output(c, codeErrParamsInvalid, http.StatusBadRequest)
+		return
+	}
+
+	if !workers.Contains(req.ChannelName) {
+		slog.Error("handlerUpdateTimeout channel not existed", "channelName", req.ChannelName, "requestId", req.RequestId, logTag)
+		s.output(c, codeErrChannelNotExisted, http.StatusBadRequest)
+		return
+	}
+
+	// Update worker so the timeoutWorkers monitor keeps it alive with the new timeout
+	worker := workers.Get(req.ChannelName).(*Worker)
+	worker.QuitTimeoutSeconds = req.QuitTimeoutSeconds
+	worker.UpdateTs = time.Now().Unix()
+
+	slog.Info("handlerUpdateTimeout end", "worker", worker, "requestId", req.RequestId, logTag)
+	s.output(c, codeSuccess, map[string]any{"channel_name": req.ChannelName, "timeout": worker.QuitTimeoutSeconds})
+}
+
 func (s *HttpServer) handlerStart(c *gin.Context) {
 	workersRunning := workers.Size()
 
@@ -258,10 +359,37 @@ func (s *HttpServer) handlerStart(c *gin.Context) {
 		return
 	}
 
+	// Validate the requested graph against the app's predefined graphs, so
+	// an invalid graph name fails here with a clear error instead of
+	// launching a worker that dies later.
+	if req.GraphName != "" {
+		if names, err := s.predefinedGraphNames(); err != nil {
+			slog.Warn("handlerStart skip graph validation", "err", err, "requestId", req.RequestId, logTag)
+		} else if _, ok := names[req.GraphName]; !ok {
+			slog.Error("handlerStart graph not found", "graphName", req.GraphName, "requestId", req.RequestId, logTag)
+			s.output(c, codeErrGraphNotFound, http.StatusBadRequest)
+			return
+		}
+	}
+
 	if workers.Contains(req.ChannelName) {
-		slog.Error("handlerStart channel existed", "channelName", req.ChannelName, "requestId", req.RequestId, logTag)
-		s.output(c, codeErrChannelExisted, http.StatusBadRequest)
-		return
+		if !req.Replace {
+			// Overwriting the map entry would orphan the running process, so
+			// a duplicate start is rejected unless the caller explicitly asks
+			// for a replace.
+			slog.Error("handlerStart channel existed", "channelName", req.ChannelName, "requestId", req.RequestId, logTag)
+			s.output(c, codeErrChannelExisted, http.StatusConflict)
+			return
+		}
+
+		worker := workers.Get(req.ChannelName).(*Worker)
+		slog.Info("handlerStart replacing existing worker", "channelName", req.ChannelName, "pid", worker.Pid, "requestId", req.RequestId, logTag)
+		if err := worker.stop(req.RequestId, req.ChannelName); err != nil {
+			slog.Error("handlerStart replace stop failed", "err", err, "channelName", req.ChannelName, "requestId", req.RequestId, logTag)
+			s.output(c, codeErrStopWorkerFailed, http.StatusInternalServerError)
+			return
+		}
+		workers.Remove(req.ChannelName)
 	}
 
 	// Check if the graphName contains "gemini"
@@ -283,7 +411,13 @@ func (s *HttpServer) handlerStart(c *gin.Context) {
 		}
 	}
 
-	req.WorkerHttpServerPort = getHttpServerPort()
+	workerHttpServerPort, err := getHttpServerPort()
+	if err != nil {
+		slog.Error("handlerStart allocate worker port failed", "err", err, "requestId", req.RequestId, logTag)
+		s.output(c, codeErrNoAvailableWorkerPort, http.StatusTooManyRequests)
+		return
+	}
+	req.WorkerHttpServerPort = workerHttpServerPort
 
 	// Security: Always use launch tenapp_dir, ignore request tenapp_dir to prevent path traversal attacks
 	tenappDir := s.config.TenappDir
@@ -300,6 +434,9 @@ func (s *HttpServer) handlerStart(c *gin.Context) {
 	}
 
 	worker := newWorker(req.ChannelName, logFile, s.config.Log2Stdout, propertyJsonFile, tenappDir)
+	worker.MemoryLimitMB = s.config.WorkerMemoryLimitMB
+	worker.CgroupDir = s.config.WorkerCgroupDir
+	worker.LogSampleEvery = s.config.WorkerLogSampleEvery
 	worker.HttpServerPort = req.WorkerHttpServerPort
 	worker.GraphName = req.GraphName // Save graphName in the Worker instance
 
@@ -311,13 +448,14 @@ func (s *HttpServer) handlerStart(c *gin.Context) {
 
 	if err := worker.start(&req); err != nil {
 		slog.Error("handlerStart start worker failed", "err", err, "requestId", req.RequestId, logTag)
+		releaseHttpServerPort(worker.HttpServerPort)
 		s.output(c, codeErrStartWorkerFailed, http.StatusInternalServerError)
 		return
 	}
 	workers.SetIfNotExist(req.ChannelName, worker)
 
 	slog.Info("handlerStart end", "workersRunning", workers.Size(), "worker", worker, "requestId", req.RequestId, logTag)
-	s.output(c, codeSuccess, nil)
+	s.output(c, codeSuccess, map[string]any{"channel_name": req.ChannelName, "worker_http_server_port": worker.HttpServerPort})
 }
 
 func (s *HttpServer) handlerStop(c *gin.Context) {
@@ -354,6 +492,43 @@ func (s *HttpServer) handlerStop(c *gin.Context) {
 	s.output(c, codeSuccess, nil)
 }
 
+// handlerStopByGraph stops every worker whose GraphName matches the `graph`
+// query parameter, so an operator can roll back one agent type without
+// touching the others.
+func (s *HttpServer) handlerStopByGraph(c *gin.Context) {
+	graphName := strings.TrimSpace(c.Query("graph"))
+	if graphName == "" {
+		slog.Error("handlerStopByGraph graph empty", logTag)
+		s.output(c, codeErrParamsInvalid, http.StatusBadRequest)
+		return
+	}
+
+	slog.Info("handlerStopByGraph start", "graphName", graphName, logTag)
+
+	stopped := []string{}
+	failed := []string{}
+	for _, channelName := range workers.Keys() {
+		worker, ok := workers.Get(channelName).(*Worker)
+		if !ok || worker.GraphName != graphName {
+			continue
+		}
+		if err := worker.stop("", worker.ChannelName); err != nil {
+			slog.Error("handlerStopByGraph kill app failed", "err", err, "channelName", worker.ChannelName, "graphName", graphName, logTag)
+			failed = append(failed, worker.ChannelName)
+			continue
+		}
+		stopped = append(stopped, worker.ChannelName)
+	}
+
+	if len(failed) > 0 {
+		s.output(c, codeErrStopWorkerFailed, map[string]any{"graph_name": graphName, "channels": stopped, "failed": failed}, http.StatusInternalServerError)
+		return
+	}
+
+	slog.Info("handlerStopByGraph end", "graphName", graphName, "stopped", stopped, logTag)
+	s.output(c, codeSuccess, map[string]any{"graph_name": graphName, "channels": stopped})
+}
+
 func (s *HttpServer) handlerGenerateToken(c *gin.Context) {
 	var req GenerateTokenReq
 
@@ -886,7 +1061,9 @@ func (s *HttpServer) Start() {
 	r.GET("/list", s.handlerList)
 	r.POST("/start", s.handlerStart)
 	r.POST("/stop", s.handlerStop)
+	r.POST("/workers/stop", s.handlerStopByGraph)
 	r.POST("/ping", s.handlerPing)
+	r.POST("/timeout/update", s.handlerUpdateTimeout)
 	r.GET("/graphs", s.handleGraphs)
 	r.GET("/dev-tmp/addons/default-properties", s.handleAddonDefaultProperties)
 	r.POST("/token/generate", s.handlerGenerateToken)
@@ -913,9 +1090,9 @@ func sanitizeChannelName(channelName string) (string, error) {
 
 	// Check for path traversal characters
 	if strings.Contains(channelName, "..") ||
-	   strings.Contains(channelName, "/") ||
-	   strings.Contains(channelName, "\\") ||
-	   strings.Contains(channelName, "\x00") {
+		strings.Contains(channelName, "/") ||
+		strings.Contains(channelName, "\\") ||
+		strings.Contains(channelName, "\x00") {
 		return "", fmt.Errorf("channel name contains invalid characters")
 	}
 
@@ -0,0 +1,124 @@
+package internal
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+)
+
+// SamplingWriter compresses runs of identical log lines under high volume.
+// The first line of a run passes through; repeats are suppressed except for
+// an optional 1-in-N sample, and when the run ends the last line is written
+// together with a repeat count. It sits between a PrefixWriter and its
+// destination, so whole prefixed lines arrive per Write.
+type SamplingWriter struct {
+	writer io.Writer
+
+	// sampleEvery passes one of every N suppressed repeats through, so a
+	// long run still shows signs of life in the log; zero disables the
+	// mid-run sampling.
+	sampleEvery int
+
+	mu       sync.Mutex
+	runLine  string
+	runCount int
+}
+
+// NewSamplingWriter wraps a writer with identical-line run compression.
+func NewSamplingWriter(writer io.Writer, sampleEvery int) *SamplingWriter {
+	return &SamplingWriter{
+		writer:      writer,
+		sampleEvery: sampleEvery,
+	}
+}
+
+// Write implements the io.Writer interface. Lines are split manually rather
+// than with a bufio.Scanner: the scanner caps tokens at 64KB, and the
+// PrefixWriter upstream can emit lines right at its own 64KB limit plus the
+// prefix. A failed mid-batch write reports the bytes actually consumed, per
+// the io.Writer contract.
+func (sw *SamplingWriter) Write(p []byte) (int, error) {
+	sw.mu.Lock()
+	defer sw.mu.Unlock()
+
+	consumed := 0
+	rest := string(p)
+	for len(rest) > 0 {
+		line, remainder, terminated := strings.Cut(rest, "\n")
+		if err := sw.writeLine(line); err != nil {
+			return consumed, err
+		}
+
+		consumed += len(line)
+		if terminated {
+			consumed++
+		}
+		rest = remainder
+	}
+
+	return consumed, nil
+}
+
+// writeLine handles one line under the lock.
+func (sw *SamplingWriter) writeLine(line string) error {
+	if line == sw.runLine && sw.runCount > 0 {
+		sw.runCount++
+		if sw.sampleEvery > 0 && sw.runCount%sw.sampleEvery == 0 {
+			_, err := fmt.Fprintln(sw.writer, line)
+			return err
+		}
+		return nil
+	}
+
+	if err := sw.flushRun(); err != nil {
+		return err
+	}
+
+	sw.runLine = line
+	sw.runCount = 1
+	_, err := fmt.Fprintln(sw.writer, line)
+	return err
+}
+
+// flushRun closes out the current run: when lines were suppressed it writes
+// the last line of the run and how often it repeated.
+func (sw *SamplingWriter) flushRun() error {
+	if sw.runCount > 1 {
+		if _, err := fmt.Fprintln(sw.writer, sw.runLine); err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(
+			sw.writer,
+			"(last line repeated %d times)\n",
+			sw.runCount,
+		); err != nil {
+			return err
+		}
+	}
+
+	sw.runLine = ""
+	sw.runCount = 0
+	return nil
+}
+
+// Flush writes any pending run summary; call it when the stream ends so a
+// trailing run is not lost.
+func (sw *SamplingWriter) Flush() error {
+	sw.mu.Lock()
+	defer sw.mu.Unlock()
+
+	return sw.flushRun()
+}
+
+// maybeSampled wraps a writer with a SamplingWriter when the worker opts in
+// via LogSampleEvery; otherwise the writer passes through unchanged. The
+// returned flush is a no-op when sampling is off.
+func (w *Worker) maybeSampled(writer io.Writer) (io.Writer, func()) {
+	if w.LogSampleEvery <= 0 {
+		return writer, func() {}
+	}
+
+	sampler := NewSamplingWriter(writer, w.LogSampleEvery)
+	return sampler, func() { sampler.Flush() }
+}
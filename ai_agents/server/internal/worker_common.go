@@ -2,9 +2,11 @@ package internal
 
 import (
 	"bufio"
+	"errors"
 	"fmt"
 	"io"
 	"log/slog"
+	"net"
 	"net/http"
 	"strings"
 	"sync/atomic"
@@ -27,6 +29,15 @@ type Worker struct {
 	QuitTimeoutSeconds int
 	CreateTs           int64
 	UpdateTs           int64
+
+	// Optional resource limits applied before the worker starts; zero values
+	// leave the worker unconstrained.
+	MemoryLimitMB int
+	CgroupDir     string
+
+	// LogSampleEvery enables identical-line log sampling for this worker's
+	// output when positive; zero keeps every line. See SamplingWriter.
+	LogSampleEvery int
 }
 
 type WorkerUpdateReq struct {
@@ -53,6 +64,10 @@ var (
 	httpServerPort    = httpServerPortMin
 	httpServerPortMin = int32(10000)
 	httpServerPortMax = int32(30000)
+
+	// Ports currently held by running workers, so churn can't hand the same
+	// port to two workers before the first one exits
+	httpServerPortsInUse = gmap.New(true)
 )
 
 func newWorker(channelName string, logFile string, log2Stdout bool, propertyJsonFile string, tenappDir string) *Worker {
@@ -68,17 +83,63 @@ func newWorker(channelName string, logFile string, log2Stdout bool, propertyJson
 	}
 }
 
-func getHttpServerPort() int32 {
-	for {
+// removeFromWorkers removes this worker's map entry, but only while the entry
+// still refers to this worker. After a channel is replaced, its name is owned
+// by a newer worker; removing by name alone would evict that live worker from
+// the timeout monitor and the stop path.
+func (w *Worker) removeFromWorkers() {
+	workers.LockFunc(func(m map[interface{}]interface{}) {
+		if current, ok := m[w.ChannelName].(*Worker); ok && current == w {
+			delete(m, w.ChannelName)
+		}
+	})
+}
+
+func getHttpServerPort() (int32, error) {
+	poolSize := httpServerPortMax - httpServerPortMin + 1
+
+	for probed := int32(0); probed < poolSize; probed++ {
 		old := atomic.LoadInt32(&httpServerPort)
 		new := old + 1
 		if new > httpServerPortMax {
 			new = httpServerPortMin
 		}
-		if atomic.CompareAndSwapInt32(&httpServerPort, old, new) {
-			return new
+		if !atomic.CompareAndSwapInt32(&httpServerPort, old, new) {
+			probed-- // Lost the race, retry without consuming an attempt
+			continue
+		}
+
+		// Skip ports held by running workers
+		if httpServerPortsInUse.Contains(new) {
+			continue
 		}
+
+		// Guard against handing out a port something else is already bound to
+		if !isPortAvailable(new) {
+			slog.Warn("Worker port already in use, skipping", "port", new, logTag)
+			continue
+		}
+
+		httpServerPortsInUse.Set(new, struct{}{})
+		return new, nil
+	}
+
+	return 0, fmt.Errorf("no available worker port in range %d-%d", httpServerPortMin, httpServerPortMax)
+}
+
+// releaseHttpServerPort returns a worker's port to the pool after it exits.
+func releaseHttpServerPort(port int32) {
+	httpServerPortsInUse.Remove(port)
+}
+
+// isPortAvailable probes a port by binding it and releasing it immediately.
+func isPortAvailable(port int32) bool {
+	ln, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	if err != nil {
+		return false
 	}
+	ln.Close()
+	return true
 }
 
 // PrefixWriter is a custom writer that prefixes each line with a PID.
@@ -117,6 +178,11 @@ func (pw *PrefixWriter) Write(p []byte) (n int, err error) {
 // The start(), stop(), getRunningWorkerPIDs(), and killProcess() functions
 // are implemented separately for each platform.
 
+// ErrWorkerUpdateTimeout reports that a worker did not answer a
+// property-update post within the configured deadline, so callers can tell a
+// hung worker apart from other update failures.
+var ErrWorkerUpdateTimeout = errors.New("worker update timed out")
+
 func (w *Worker) update(req *WorkerUpdateReq) (err error) {
 	slog.Info("Worker update start", "channelName", req.ChannelName, "requestId", req.RequestId, logTag)
 
@@ -129,11 +195,15 @@ func (w *Worker) update(req *WorkerUpdateReq) (err error) {
 	}()
 
 	workerUpdateUrl := fmt.Sprintf("%s:%d/cmd", workerHttpServerUrl, w.HttpServerPort)
-	res, err = HttpClient.R().
+	res, err = workerUpdateClient.R().
 		SetHeader("Content-Type", "application/json").
 		SetBody(req).
 		Post(workerUpdateUrl)
 	if err != nil {
+		var netErr net.Error
+		if errors.As(err, &netErr) && netErr.Timeout() {
+			err = fmt.Errorf("%w: %v", ErrWorkerUpdateTimeout, err)
+		}
 		return
 	}
 
@@ -0,0 +1,23 @@
+//go:build darwin
+// +build darwin
+
+package internal
+
+import (
+	"log/slog"
+	"os/exec"
+)
+
+// configureResourceLimits is a no-op on darwin: neither cgroups nor
+// inheritable address-space limits are available, so worker resource limits
+// are only enforced on linux.
+func (w *Worker) configureResourceLimits(
+	cmd *exec.Cmd,
+	requestId string,
+) (func(), error) {
+	if w.CgroupDir != "" || w.MemoryLimitMB > 0 {
+		slog.Warn("Worker resource limits are not supported on this platform", "requestId", requestId, logTag)
+	}
+
+	return func() {}, nil
+}
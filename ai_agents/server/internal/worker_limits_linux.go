@@ -0,0 +1,96 @@
+//go:build linux
+// +build linux
+
+package internal
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"sync"
+
+	"golang.org/x/sys/unix"
+)
+
+// rlimitStartMu serializes worker starts that use the rlimit fallback, since
+// that path temporarily changes the server's own soft limit around the fork.
+var rlimitStartMu sync.Mutex
+
+// configureResourceLimits prepares the worker's resource limits BEFORE the
+// process starts, so it never executes unconstrained and nothing it forks can
+// escape them. A cgroup v2 directory is preferred: the limit is written first
+// and the child is cloned directly into the cgroup via CgroupFD. Without a
+// cgroup, the fallback sets the server's own soft RLIMIT_AS around the start
+// — rlimits are inherited across fork/exec — and restores it afterwards via
+// the returned cleanup, which must be called once cmd.Start has returned.
+func (w *Worker) configureResourceLimits(
+	cmd *exec.Cmd,
+	requestId string,
+) (cleanup func(), err error) {
+	cleanup = func() {}
+
+	if w.CgroupDir == "" && w.MemoryLimitMB <= 0 {
+		return cleanup, nil
+	}
+
+	if w.CgroupDir != "" {
+		fd, err := w.prepareCgroup()
+		if err != nil {
+			return cleanup, fmt.Errorf("prepare cgroup %s: %w", w.CgroupDir, err)
+		}
+
+		cmd.SysProcAttr.UseCgroupFD = true
+		cmd.SysProcAttr.CgroupFD = fd
+
+		slog.Info("Worker cgroup configured", "cgroupDir", w.CgroupDir, "memoryLimitMB", w.MemoryLimitMB, "requestId", requestId, logTag)
+		return func() { unix.Close(fd) }, nil
+	}
+
+	// Only the soft limit is lowered: lowering the server's hard limit would
+	// be irreversible without privilege. The worker inherits the soft limit
+	// at fork, which is what stops a runaway allocation.
+	limitBytes := uint64(w.MemoryLimitMB) * 1024 * 1024
+
+	rlimitStartMu.Lock()
+
+	var saved unix.Rlimit
+	if err := unix.Getrlimit(unix.RLIMIT_AS, &saved); err != nil {
+		rlimitStartMu.Unlock()
+		return cleanup, fmt.Errorf("read RLIMIT_AS: %w", err)
+	}
+
+	rlim := unix.Rlimit{Cur: limitBytes, Max: saved.Max}
+	if rlim.Max != unix.RLIM_INFINITY && rlim.Cur > rlim.Max {
+		rlim.Cur = rlim.Max
+	}
+	if err := unix.Setrlimit(unix.RLIMIT_AS, &rlim); err != nil {
+		rlimitStartMu.Unlock()
+		return cleanup, fmt.Errorf("set RLIMIT_AS: %w", err)
+	}
+
+	slog.Info("Worker rlimit configured", "memoryLimitMB", w.MemoryLimitMB, "requestId", requestId, logTag)
+
+	return func() {
+		if err := unix.Setrlimit(unix.RLIMIT_AS, &saved); err != nil {
+			slog.Error("Worker rlimit restore failed", "err", err, "requestId", requestId, logTag)
+		}
+		rlimitStartMu.Unlock()
+	}, nil
+}
+
+// prepareCgroup writes the memory limit into the configured cgroup v2
+// directory and opens the directory for CgroupFD, so the worker is cloned
+// straight into the constrained cgroup.
+func (w *Worker) prepareCgroup() (int, error) {
+	if w.MemoryLimitMB > 0 {
+		limit := strconv.Itoa(w.MemoryLimitMB * 1024 * 1024)
+		if err := os.WriteFile(filepath.Join(w.CgroupDir, "memory.max"), []byte(limit), 0644); err != nil {
+			return -1, err
+		}
+	}
+
+	return unix.Open(w.CgroupDir, unix.O_PATH|unix.O_CLOEXEC, 0)
+}
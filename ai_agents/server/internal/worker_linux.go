@@ -34,6 +34,9 @@ func (w *Worker) start(req *StartReq) (err error) {
 		Setpgid: true, // Start a new process group
 	}
 
+	// Expose the session metadata to the extensions inside the worker.
+	cmd.Env = w.sessionEnv()
+
 	// Set working directory if tenapp_dir is specified
 	if w.TenappDir != "" {
 		cmd.Dir = w.TenappDir
@@ -48,28 +51,51 @@ func (w *Worker) start(req *StartReq) (err error) {
 		var openErr error
 		logFile, openErr = os.OpenFile(w.LogFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
 		if openErr != nil {
+			// Launching anyway would produce a worker whose output silently
+			// vanishes; abort the start instead.
 			slog.Error("Failed to open log file", "err", openErr, "requestId", req.RequestId, logTag)
-		} else {
-			stdoutWriter = logFile
-			stderrWriter = logFile
+			return fmt.Errorf("open worker log file %s: %w", w.LogFile, openErr)
 		}
+		stdoutWriter = logFile
+		stderrWriter = logFile
 	}
 
+	// Optionally compress identical-line runs before they hit the file or
+	// stdout path.
+	sampledStdout, flushStdout := w.maybeSampled(stdoutWriter)
+	sampledStderr, flushStderr := w.maybeSampled(stderrWriter)
+
 	// Create PrefixWriter instances with appropriate writers
 	stdoutPrefixWriter := &PrefixWriter{
 		prefix: "-", // Initial prefix, will update after process starts
-		writer: stdoutWriter,
+		writer: sampledStdout,
 	}
 	stderrPrefixWriter := &PrefixWriter{
 		prefix: "-", // Initial prefix, will update after process starts
-		writer: stderrWriter,
+		writer: sampledStderr,
 	}
 
 	cmd.Stdout = stdoutPrefixWriter
 	cmd.Stderr = stderrPrefixWriter
 
-	if err = cmd.Start(); err != nil {
+	// Constrain the worker before it is forked, so it never runs — and
+	// nothing it forks can escape — unconstrained.
+	cleanupLimits, limitsErr := w.configureResourceLimits(cmd, req.RequestId)
+	if limitsErr != nil {
+		slog.Error("Worker resource limits failed", "err", limitsErr, "requestId", req.RequestId, logTag)
+		if logFile != nil {
+			logFile.Close()
+		}
+		return fmt.Errorf("configure worker resource limits: %w", limitsErr)
+	}
+
+	err = cmd.Start()
+	cleanupLimits()
+	if err != nil {
 		slog.Error("Worker start failed", "err", err, "requestId", req.RequestId, logTag)
+		if logFile != nil {
+			logFile.Close()
+		}
 		return
 	}
 
@@ -105,15 +131,19 @@ func (w *Worker) start(req *StartReq) (err error) {
 		} else {
 			slog.Info("Worker process completed successfully", "requestId", req.RequestId, logTag)
 		}
-		// Close the log file when the command finishes
+		// Write any pending sampling summaries, then close the log file.
+		flushStdout()
+		flushStderr()
 		if logFile != nil {
 			logFile.Close()
 		}
 
-		// Remove the worker from the map (defensive check for concurrent stop)
-		if workers.Contains(w.ChannelName) {
-			workers.Remove(w.ChannelName)
-		}
+		// Remove the worker from the map, but only while the entry is still
+		// ours — the channel may have been replaced with a new worker.
+		w.removeFromWorkers()
+
+		// Return the worker's port to the pool
+		releaseHttpServerPort(w.HttpServerPort)
 
 	}()
 
@@ -133,9 +163,7 @@ func (w *Worker) stop(requestId string, channelName string) (err error) {
 			slog.Error("Worker SIGKILL failed", "err", err, "channelName", channelName, "worker", w, "requestId", requestId, logTag)
 			return
 		}
-		if workers.Contains(channelName) {
-			workers.Remove(channelName)
-		}
+		w.removeFromWorkers()
 		slog.Info("Worker stop end (forced)", "channelName", channelName, "worker", w, "requestId", requestId, logTag)
 		return
 	}
@@ -146,9 +174,7 @@ func (w *Worker) stop(requestId string, channelName string) (err error) {
 		err = syscall.Kill(-w.Pid, 0)
 		if err != nil {
 			// Process no longer exists - graceful shutdown succeeded
-			if workers.Contains(channelName) {
-				workers.Remove(channelName)
-			}
+			w.removeFromWorkers()
 			slog.Info("Worker stop end (graceful)", "channelName", channelName, "worker", w, "requestId", requestId, logTag)
 			return nil
 		}
@@ -163,9 +189,7 @@ func (w *Worker) stop(requestId string, channelName string) (err error) {
 		return
 	}
 
-	if workers.Contains(channelName) {
-		workers.Remove(channelName)
-	}
+	w.removeFromWorkers()
 	slog.Info("Worker stop end (forced after timeout)", "channelName", channelName, "worker", w, "requestId", requestId, logTag)
 	return
 }
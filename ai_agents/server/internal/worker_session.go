@@ -0,0 +1,37 @@
+package internal
+
+import (
+	"os"
+	"strconv"
+)
+
+// Environment variables injected into every worker process so the agent
+// extensions running inside it can read their own session metadata (e.g. to
+// warn a user before a timeout-triggered stop). The values reflect the state
+// at launch time; a later timeout update via the HTTP API is not propagated
+// into an already running worker.
+const (
+	// The RTC channel name the worker serves.
+	WorkerEnvChannelName = "TEN_AGENT_SESSION_CHANNEL_NAME"
+
+	// The graph the worker was started with.
+	WorkerEnvGraphName = "TEN_AGENT_SESSION_GRAPH_NAME"
+
+	// Unix timestamp (seconds) of when the worker was created.
+	WorkerEnvStartTs = "TEN_AGENT_SESSION_START_TS"
+
+	// Idle timeout in seconds after which the server stops the worker;
+	// WORKER_TIMEOUT_INFINITY (-1) means the worker is never timed out.
+	WorkerEnvQuitTimeoutSeconds = "TEN_AGENT_SESSION_QUIT_TIMEOUT_SECONDS"
+)
+
+// sessionEnv builds the environment for the worker process: the server's own
+// environment plus the session metadata variables above.
+func (w *Worker) sessionEnv() []string {
+	return append(os.Environ(),
+		WorkerEnvChannelName+"="+w.ChannelName,
+		WorkerEnvGraphName+"="+w.GraphName,
+		WorkerEnvStartTs+"="+strconv.FormatInt(w.CreateTs, 10),
+		WorkerEnvQuitTimeoutSeconds+"="+strconv.Itoa(w.QuitTimeoutSeconds),
+	)
+}
@@ -4,6 +4,7 @@
 package internal
 
 import (
+	"fmt"
 	"log/slog"
 	"os"
 	"os/exec"
@@ -23,6 +24,9 @@ func (w *Worker) start(req *StartReq) (err error) {
 		CreationFlags: syscall.CREATE_NEW_PROCESS_GROUP,
 	}
 
+	// Expose the session metadata to the extensions inside the worker.
+	cmd.Env = w.sessionEnv()
+
 	// Set working directory if tenapp_dir is specified
 	if w.TenappDir != "" {
 		cmd.Dir = w.TenappDir
@@ -37,21 +41,28 @@ func (w *Worker) start(req *StartReq) (err error) {
 		var openErr error
 		logFile, openErr = os.OpenFile(w.LogFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
 		if openErr != nil {
+			// Launching anyway would produce a worker whose output silently
+			// vanishes; abort the start instead.
 			slog.Error("Failed to open log file", "err", openErr, "requestId", req.RequestId, logTag)
-		} else {
-			stdoutWriter = logFile
-			stderrWriter = logFile
+			return fmt.Errorf("open worker log file %s: %w", w.LogFile, openErr)
 		}
+		stdoutWriter = logFile
+		stderrWriter = logFile
 	}
 
+	// Optionally compress identical-line runs before they hit the file or
+	// stdout path.
+	sampledStdout, flushStdout := w.maybeSampled(stdoutWriter)
+	sampledStderr, flushStderr := w.maybeSampled(stderrWriter)
+
 	// Create PrefixWriter instances with appropriate writers
 	stdoutPrefixWriter := &PrefixWriter{
 		prefix: "-", // Initial prefix, will update after process starts
-		writer: stdoutWriter,
+		writer: sampledStdout,
 	}
 	stderrPrefixWriter := &PrefixWriter{
 		prefix: "-", // Initial prefix, will update after process starts
-		writer: stderrWriter,
+		writer: sampledStderr,
 	}
 
 	cmd.Stdout = stdoutPrefixWriter
@@ -77,15 +88,19 @@ func (w *Worker) start(req *StartReq) (err error) {
 		} else {
 			slog.Info("Worker process completed successfully", "requestId", req.RequestId, logTag)
 		}
-		// Close the log file when the command finishes
+		// Write any pending sampling summaries, then close the log file.
+		flushStdout()
+		flushStderr()
 		if logFile != nil {
 			logFile.Close()
 		}
 
-		// Remove the worker from the map (defensive check for concurrent stop)
-		if workers.Contains(w.ChannelName) {
-			workers.Remove(w.ChannelName)
-		}
+		// Remove the worker from the map, but only while the entry is still
+		// ours — the channel may have been replaced with a new worker.
+		w.removeFromWorkers()
+
+		// Return the worker's port to the pool
+		releaseHttpServerPort(w.HttpServerPort)
 
 	}()
 
@@ -100,9 +115,7 @@ func (w *Worker) stop(requestId string, channelName string) (err error) {
 	handle, err := syscall.OpenProcess(syscall.PROCESS_TERMINATE, false, uint32(w.Pid))
 	if err != nil {
 		slog.Error("Worker open process failed", "err", err, "channelName", channelName, "pid", w.Pid, "requestId", requestId, logTag)
-		if workers.Contains(channelName) {
-			workers.Remove(channelName)
-		}
+		w.removeFromWorkers()
 		return
 	}
 	defer syscall.CloseHandle(handle)
@@ -116,9 +129,7 @@ func (w *Worker) stop(requestId string, channelName string) (err error) {
 		err = syscall.GetExitCodeProcess(handle, &exitCode)
 		if err != nil || exitCode != 259 { // 259 = STILL_ACTIVE
 			// Process no longer exists
-			if workers.Contains(channelName) {
-				workers.Remove(channelName)
-			}
+			w.removeFromWorkers()
 			slog.Info("Worker stop end (process already exited)", "channelName", channelName, "worker", w, "requestId", requestId, logTag)
 			return nil
 		}
@@ -133,9 +144,7 @@ func (w *Worker) stop(requestId string, channelName string) (err error) {
 		return
 	}
 
-	if workers.Contains(channelName) {
-		workers.Remove(channelName)
-	}
+	w.removeFromWorkers()
 	slog.Info("Worker stop end (forced)", "channelName", channelName, "worker", w, "requestId", requestId, logTag)
 	return
 }
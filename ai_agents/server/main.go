@@ -62,6 +62,18 @@ func main() {
 		os.Exit(1)
 	}
 
+	// MAX_WORKER_COUNT is the general counterpart of MAX_GEMINI_WORKER_COUNT:
+	// a cap on total concurrent workers. When set it overrides WORKERS_MAX,
+	// and the start handler rejects requests past it with 429.
+	if v := os.Getenv("MAX_WORKER_COUNT"); v != "" {
+		maxWorkerCount, err := strconv.Atoi(v)
+		if err != nil || maxWorkerCount <= 0 {
+			slog.Error("environment MAX_WORKER_COUNT invalid")
+			os.Exit(1)
+		}
+		workersMax = maxWorkerCount
+	}
+
 	// Read worker quit timeout with support for legacy misspelled var
 	workerQuitTimeoutEnv := os.Getenv("WORKER_QUIT_TIMEOUT_SECONDS")
 	if workerQuitTimeoutEnv == "" {
@@ -73,6 +85,36 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Optional deadline for property-update posts to workers
+	workerUpdateTimeoutSeconds := 0
+	if v := os.Getenv("WORKER_UPDATE_TIMEOUT_SECONDS"); v != "" {
+		workerUpdateTimeoutSeconds, err = strconv.Atoi(v)
+		if err != nil || workerUpdateTimeoutSeconds <= 0 {
+			slog.Error("environment WORKER_UPDATE_TIMEOUT_SECONDS invalid")
+			os.Exit(1)
+		}
+	}
+
+	// Optional worker resource limits
+	workerMemoryLimitMB := 0
+	if v := os.Getenv("WORKER_MEMORY_LIMIT_MB"); v != "" {
+		workerMemoryLimitMB, err = strconv.Atoi(v)
+		if err != nil || workerMemoryLimitMB < 0 {
+			slog.Error("environment WORKER_MEMORY_LIMIT_MB invalid")
+			os.Exit(1)
+		}
+	}
+
+	// Optional identical-line log sampling for worker output
+	workerLogSampleEvery := 0
+	if v := os.Getenv("WORKER_LOG_SAMPLE_EVERY"); v != "" {
+		workerLogSampleEvery, err = strconv.Atoi(v)
+		if err != nil || workerLogSampleEvery < 0 {
+			slog.Error("environment WORKER_LOG_SAMPLE_EVERY invalid")
+			os.Exit(1)
+		}
+	}
+
 	// Set up signal handler to clean up all workers on Ctrl+C
 	sigs := make(chan os.Signal, 1)
 	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
@@ -86,14 +128,18 @@ func main() {
 
 	// Start server
 	httpServerConfig := &internal.HttpServerConfig{
-		AppId:                    agoraAppId,
-		AppCertificate:           os.Getenv("AGORA_APP_CERTIFICATE"),
-		LogPath:                  logPath,
-		Port:                     os.Getenv("SERVER_PORT"),
-		WorkersMax:               workersMax,
-		WorkerQuitTimeoutSeconds: workerQuitTimeoutSeconds,
-		Log2Stdout:               log2Stdout,
-		TenappDir:                tenappDir,
+		AppId:                      agoraAppId,
+		AppCertificate:             os.Getenv("AGORA_APP_CERTIFICATE"),
+		LogPath:                    logPath,
+		Port:                       os.Getenv("SERVER_PORT"),
+		WorkersMax:                 workersMax,
+		WorkerQuitTimeoutSeconds:   workerQuitTimeoutSeconds,
+		WorkerUpdateTimeoutSeconds: workerUpdateTimeoutSeconds,
+		Log2Stdout:                 log2Stdout,
+		TenappDir:                  tenappDir,
+		WorkerMemoryLimitMB:        workerMemoryLimitMB,
+		WorkerCgroupDir:            os.Getenv("WORKER_CGROUP_DIR"),
+		WorkerLogSampleEvery:       workerLogSampleEvery,
 	}
 
 	slog.Info("Server configured with tenapp_dir", "tenappDir", tenappDir)
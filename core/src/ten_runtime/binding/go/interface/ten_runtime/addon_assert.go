@@ -0,0 +1,49 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import "sort"
+
+// isRegistered reports whether an addon with the given name has been added
+// to this manager's registry.
+func (am *AddonManager) isRegistered(addonName string) bool {
+	am.registryMutex.RLock()
+	defer am.registryMutex.RUnlock()
+
+	_, exists := am.registry[addonName]
+	return exists
+}
+
+// registeredNames returns the names in this manager's registry, sorted.
+func (am *AddonManager) registeredNames() []string {
+	am.registryMutex.RLock()
+	defer am.registryMutex.RUnlock()
+
+	names := make([]string, 0, len(am.registry))
+	for name := range am.registry {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+// AssertAddonRegistered reports whether an addon with the given name has
+// been registered, so test suites can verify an addon loaded without relying
+// on init-time panics. It covers addons added with RegisterAddonAsExtension,
+// whether or not the native manager has called their register handler yet.
+func AssertAddonRegistered(addonName string) bool {
+	return defaultAddonManager.isRegistered(addonName)
+}
+
+// RegisteredAddonNames returns the names of every registered addon, sorted,
+// for diagnostics when AssertAddonRegistered fails and the suspicion is a
+// misspelled or renamed addon.
+func RegisteredAddonNames() []string {
+	return defaultAddonManager.registeredNames()
+}
@@ -0,0 +1,42 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import "testing"
+
+func TestAssertAddonRegistered(t *testing.T) {
+	am := newAddonManager()
+
+	if am.isRegistered("missing_addon") {
+		t.FailNow()
+	}
+
+	// Registration only touches the registry; the native call happens later
+	// through the register handler, so this is safe without a runtime.
+	am.registryMutex.Lock()
+	am.registry["some_addon"] = func(cHandle) error { return nil }
+	am.registryMutex.Unlock()
+
+	if !am.isRegistered("some_addon") {
+		t.FailNow()
+	}
+}
+
+func TestRegisteredAddonNamesSorted(t *testing.T) {
+	am := newAddonManager()
+
+	am.registryMutex.Lock()
+	am.registry["b_addon"] = func(cHandle) error { return nil }
+	am.registry["a_addon"] = func(cHandle) error { return nil }
+	am.registryMutex.Unlock()
+
+	names := am.registeredNames()
+	if len(names) != 2 || names[0] != "a_addon" || names[1] != "b_addon" {
+		t.FailNow()
+	}
+}
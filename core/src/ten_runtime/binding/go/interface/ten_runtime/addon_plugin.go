@@ -0,0 +1,92 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"fmt"
+	"plugin"
+	"sync"
+)
+
+// ExtensionPluginRegisterFuncName is the optional symbol LoadExtensionPlugin
+// looks up in a loaded plugin: a func() error performing explicit
+// registration. Plugins that register through init() (the usual
+// RegisterAddonAsExtension pattern) do not need to export it.
+const ExtensionPluginRegisterFuncName = "RegisterTenExtensions"
+
+// loadedExtensionPlugins dedupes loads: opening the same plugin path twice
+// would make its init() registrations fail as duplicates.
+var loadedExtensionPlugins struct {
+	sync.Mutex
+	paths map[string]struct{}
+}
+
+// LoadExtensionPlugin loads a Go plugin (.so) containing extensions and
+// registers them with the addon manager, so extensions can be deployed
+// without rebuilding the host binary.
+//
+// The plugin's init() functions run on load, which is where extensions built
+// with the usual RegisterAddonAsExtension-in-init pattern register
+// themselves. If the plugin additionally exports
+//
+//	func RegisterTenExtensions() error
+//
+// it is invoked after the load, for plugins that prefer explicit
+// registration. Loading the same path more than once is a no-op.
+//
+// Go plugin constraints apply and are strict: the plugin must be built with
+// the exact same Go toolchain version, the same build flags (including -race
+// and -trimpath), and the same versions of all shared dependencies —
+// ten_framework in particular — as the host binary, or plugin.Open fails.
+// Plugins are only supported on Linux, FreeBSD, and macOS, and can never be
+// unloaded.
+func LoadExtensionPlugin(path string) error {
+	loadedExtensionPlugins.Lock()
+	if loadedExtensionPlugins.paths == nil {
+		loadedExtensionPlugins.paths = make(map[string]struct{})
+	}
+	if _, done := loadedExtensionPlugins.paths[path]; done {
+		loadedExtensionPlugins.Unlock()
+		return nil
+	}
+	loadedExtensionPlugins.Unlock()
+
+	p, err := plugin.Open(path)
+	if err != nil {
+		return NewTenError(
+			ErrorCodeGeneric,
+			fmt.Sprintf("failed to load extension plugin %s: %v", path, err),
+		)
+	}
+
+	// The optional explicit registration hook.
+	if sym, lookupErr := p.Lookup(ExtensionPluginRegisterFuncName); lookupErr == nil {
+		register, ok := sym.(func() error)
+		if !ok {
+			return NewTenError(
+				ErrorCodeInvalidType,
+				fmt.Sprintf(
+					"extension plugin %s exports %s with signature %T, want func() error",
+					path,
+					ExtensionPluginRegisterFuncName,
+					sym,
+				),
+			)
+		}
+
+		if registerErr := register(); registerErr != nil {
+			return registerErr
+		}
+	}
+
+	loadedExtensionPlugins.Lock()
+	loadedExtensionPlugins.paths[path] = struct{}{}
+	loadedExtensionPlugins.Unlock()
+
+	return nil
+}
@@ -11,8 +11,11 @@ package ten_runtime
 import "C"
 
 import (
+	"context"
 	"fmt"
+	"os"
 	"runtime"
+	"sync"
 	"unsafe"
 )
 
@@ -54,6 +57,16 @@ func (p *DefaultApp) OnDeinit(tenEnv TenEnv) {
 type app struct {
 	IApp
 	baseTenObject[*C.ten_go_app_t]
+
+	// waitOnce/waitDone back WaitContext: only one goroutine blocks on the C
+	// wait call, no matter how many WaitContext calls are in flight.
+	waitOnce sync.Once
+	waitDone chan struct{}
+
+	// idleGCStop stops the idle-GC loop (see EnableIdleGC); nil when the
+	// option is not used.
+	idleGCStop     chan struct{}
+	idleGCStopOnce sync.Once
 }
 
 var _ App = new(app)
@@ -61,8 +74,20 @@ var _ App = new(app)
 // App is the interface for the app.
 type App interface {
 	Run(runInBackground bool)
+
+	// RunWithSignals runs the app and blocks until it stops, translating
+	// the given signals (default: SIGINT, SIGTERM) into a graceful Close so
+	// extension cleanup runs on a container stop.
+	RunWithSignals(signals ...os.Signal)
+
 	Close()
 	Wait()
+
+	// WaitContext blocks until the app stops or the context is canceled,
+	// whichever comes first. Canceling the context only unblocks the call, it
+	// does not stop the app -- use Close for that. It returns nil when the app
+	// has stopped, or the context error when the context was canceled.
+	WaitContext(ctx context.Context) error
 }
 
 func (p *app) Run(runInBackground bool) {
@@ -70,6 +95,7 @@ func (p *app) Run(runInBackground bool) {
 }
 
 func (p *app) Close() {
+	p.stopIdleGC()
 	C.ten_go_app_close(p.cPtr)
 }
 
@@ -77,9 +103,27 @@ func (p *app) Wait() {
 	C.ten_go_app_wait(p.cPtr)
 }
 
+func (p *app) WaitContext(ctx context.Context) error {
+	p.waitOnce.Do(func() {
+		p.waitDone = make(chan struct{})
+		go func() {
+			p.Wait()
+			close(p.waitDone)
+		}()
+	})
+
+	select {
+	case <-p.waitDone:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 // NewApp creates a new app.
 func NewApp(
 	iApp IApp,
+	options ...AppOption,
 ) (App, error) {
 	if iApp == nil {
 		return nil, NewTenError(
@@ -88,6 +132,8 @@ func NewApp(
 		)
 	}
 
+	opts := applyAppOptions(options)
+
 	pApp := &app{
 		IApp: iApp,
 	}
@@ -100,9 +146,12 @@ func NewApp(
 	// C app is created from Go and also destroyed from Go, which means the C
 	// app won't be destroyed until the Go finalizer function is called.
 	runtime.SetFinalizer(pApp, func(p *app) {
+		p.stopIdleGC()
 		C.ten_go_app_finalize(p.cPtr)
 	})
 
+	pApp.startIdleGC(opts.idleGCInterval)
+
 	return pApp, nil
 }
 
@@ -192,5 +241,21 @@ func tenGoAppOnDeinit(appID C.uintptr_t, tenEnvID C.uintptr_t) {
 		)
 	}
 
-	appObj.OnDeinit(tenEnvObj)
+	// Enforce teardown ordering: every extension must have completed
+	// OnDeinit before the app's OnDeinit runs, so extensions never observe
+	// an app whose resources are already gone. The wait runs on its own
+	// goroutine — never on the C callback thread — and the C runtime keeps
+	// the deinit flow open until OnDeinitDone is called. A straggler is
+	// reported instead of hanging teardown forever.
+	go func() {
+		if err := liveExtensions.waitIdle(appDeinitWaitTimeout); err != nil {
+			tenEnvObj.LogWarn(
+				fmt.Sprintf("App OnDeinit proceeding despite %v", err),
+			)
+		}
+
+		reportLeakedHandles()
+
+		appObj.OnDeinit(tenEnvObj)
+	}()
 }
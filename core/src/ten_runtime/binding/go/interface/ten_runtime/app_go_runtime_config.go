@@ -0,0 +1,54 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"fmt"
+	"runtime"
+	"runtime/debug"
+)
+
+// App-level properties tuning the Go runtime from property.json, applied when
+// the app calls OnConfigureDone. They replace ad hoc GOGC/GOMAXPROCS env
+// vars, so runtime tuning lives with the rest of the app config.
+const (
+	// GoRuntimeGCPercentPropertyName sets the garbage collection target
+	// percentage, as debug.SetGCPercent does.
+	GoRuntimeGCPercentPropertyName = "go_runtime.gc_percent"
+
+	// GoRuntimeMaxProcsPropertyName sets the number of CPUs executing Go
+	// code, as runtime.GOMAXPROCS does.
+	GoRuntimeMaxProcsPropertyName = "go_runtime.max_procs"
+)
+
+// applyGoRuntimeConfig reads the Go runtime tuning properties and applies
+// them, logging the effective values. Missing properties leave the runtime
+// untouched.
+func (p *tenEnv) applyGoRuntimeConfig() {
+	if gcPercent, err := p.GetPropertyInt64(
+		GoRuntimeGCPercentPropertyName,
+	); err == nil {
+		prev := debug.SetGCPercent(int(gcPercent))
+		p.LogInfo(fmt.Sprintf(
+			"go runtime: gc percent set to %d (was %d)",
+			gcPercent,
+			prev,
+		))
+	}
+
+	if maxProcs, err := p.GetPropertyInt64(
+		GoRuntimeMaxProcsPropertyName,
+	); err == nil && maxProcs > 0 {
+		prev := runtime.GOMAXPROCS(int(maxProcs))
+		p.LogInfo(fmt.Sprintf(
+			"go runtime: GOMAXPROCS set to %d (was %d)",
+			maxProcs,
+			prev,
+		))
+	}
+}
@@ -0,0 +1,102 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"runtime/debug"
+	"sync/atomic"
+	"time"
+)
+
+// bindingActivity counts operations crossing the Go/C boundary through the
+// cgo limiter. The idle-GC loop samples it to decide whether the app has been
+// idle for a whole interval.
+var bindingActivity atomic.Uint64
+
+type appOptions struct {
+	idleGCInterval time.Duration
+}
+
+// AppOption configures optional behavior of an app created with NewApp.
+type AppOption func(*appOptions)
+
+func applyAppOptions(options []AppOption) appOptions {
+	var opts appOptions
+	for _, option := range options {
+		if option != nil {
+			option(&opts)
+		}
+	}
+	return opts
+}
+
+// EnableIdleGC makes the app force a garbage collection (and return freed
+// memory to the OS) whenever no binding activity was observed for a whole
+// interval.
+//
+// Reclamation of C-side resources relies on Go finalizers, and finalizers
+// only run when the garbage collector does. A long-running app with low
+// message throughput allocates too slowly to trigger GC regularly, so C
+// handles can linger long after the Go objects owning them became garbage.
+// Idle GC keeps that reclamation timely without users coding manual GC loops.
+//
+// Tradeoffs: each forced collection stops the world briefly and
+// debug.FreeOSMemory is more expensive than a plain runtime.GC, so a short
+// interval on a mostly-idle process trades CPU for memory footprint. The
+// collection is skipped entirely while messages are flowing, so a busy app
+// pays nothing. This is opt-in; without this option the app never forces GC.
+func EnableIdleGC(interval time.Duration) AppOption {
+	return func(opts *appOptions) {
+		opts.idleGCInterval = interval
+	}
+}
+
+// idleGCLoop forces forceGC each time a full interval elapses with no binding
+// activity. It returns when stop is closed. The loop deliberately captures no
+// reference to the app, so a running loop does not keep the app reachable.
+func idleGCLoop(interval time.Duration, stop <-chan struct{}, forceGC func()) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	last := bindingActivity.Load()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			cur := bindingActivity.Load()
+			if cur == last {
+				forceGC()
+			}
+			last = cur
+		}
+	}
+}
+
+func (p *app) startIdleGC(interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+
+	p.idleGCStop = make(chan struct{})
+
+	// debug.FreeOSMemory forces a garbage collection (running pending
+	// finalizers' first pass) and then returns as much memory to the OS as
+	// possible.
+	go idleGCLoop(interval, p.idleGCStop, debug.FreeOSMemory)
+}
+
+func (p *app) stopIdleGC() {
+	if p.idleGCStop == nil {
+		return
+	}
+
+	p.idleGCStopOnce.Do(func() {
+		close(p.idleGCStop)
+	})
+}
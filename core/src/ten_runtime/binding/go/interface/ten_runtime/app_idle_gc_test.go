@@ -0,0 +1,81 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestIdleGCFiresWhenIdle(t *testing.T) {
+	stop := make(chan struct{})
+	defer close(stop)
+
+	var fired atomic.Int32
+	go idleGCLoop(time.Millisecond, stop, func() {
+		fired.Add(1)
+	})
+
+	deadline := time.Now().Add(time.Second)
+	for fired.Load() == 0 {
+		if time.Now().After(deadline) {
+			t.FailNow()
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestIdleGCSkippedWhileBusy(t *testing.T) {
+	stop := make(chan struct{})
+
+	var fired atomic.Int32
+	go idleGCLoop(5*time.Millisecond, stop, func() {
+		fired.Add(1)
+	})
+
+	// Keep the activity counter moving; every tick sees fresh activity, so
+	// the loop must never force a collection.
+	busyUntil := time.Now().Add(50 * time.Millisecond)
+	for time.Now().Before(busyUntil) {
+		bindingActivity.Add(1)
+		time.Sleep(time.Millisecond)
+	}
+	close(stop)
+
+	if fired.Load() != 0 {
+		t.FailNow()
+	}
+}
+
+func TestIdleGCStopIsIdempotent(t *testing.T) {
+	p := &app{}
+	p.startIdleGC(time.Hour)
+	if p.idleGCStop == nil {
+		t.FailNow()
+	}
+
+	p.stopIdleGC()
+	p.stopIdleGC()
+}
+
+func TestIdleGCDisabledByDefault(t *testing.T) {
+	opts := applyAppOptions(nil)
+	if opts.idleGCInterval != 0 {
+		t.FailNow()
+	}
+
+	p := &app{}
+	p.startIdleGC(opts.idleGCInterval)
+	if p.idleGCStop != nil {
+		t.FailNow()
+	}
+
+	// Stopping an app that never enabled idle GC is a no-op.
+	p.stopIdleGC()
+}
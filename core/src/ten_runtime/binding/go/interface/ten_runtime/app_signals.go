@@ -0,0 +1,48 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// RunWithSignals runs the app and blocks until it stops, translating the
+// given signals into a graceful Close. This way a container stop (SIGTERM)
+// drives the normal shutdown path — extensions' OnStop/OnDeinit run — instead
+// of hard-killing the process with cleanup still pending. When no signals
+// are given, SIGINT and SIGTERM are handled.
+//
+// Apps that manage signals themselves should keep using Run.
+func (p *app) RunWithSignals(signals ...os.Signal) {
+	if len(signals) == 0 {
+		signals = []os.Signal{syscall.SIGINT, syscall.SIGTERM}
+	}
+
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, signals...)
+	defer signal.Stop(sigCh)
+
+	p.Run(true)
+
+	// stopped releases the signal goroutine when the app stops on its own,
+	// so it does not linger waiting for a signal that never comes.
+	stopped := make(chan struct{})
+	defer close(stopped)
+
+	go func() {
+		select {
+		case <-sigCh:
+			p.Close()
+		case <-stopped:
+		}
+	}()
+
+	p.Wait()
+}
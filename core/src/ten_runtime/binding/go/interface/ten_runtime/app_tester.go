@@ -0,0 +1,891 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// AppTester drives the lifecycle callbacks of an IApp without spawning a real
+// TEN app process, so the OnConfigure/OnInit/OnDeinit logic (ex: property
+// loading) can be unit-tested. The ten env handed to the app is backed by an
+// in-memory property tree instead of the C runtime.
+type AppTester interface {
+	// InjectPropertyFromJSONBytes sets the initial property tree the app sees,
+	// as if it were loaded from property.json. Must be called before Run.
+	InjectPropertyFromJSONBytes(value []byte) error
+
+	// Run drives OnConfigure and OnInit synchronously.
+	Run() error
+
+	// Stop drives OnDeinit synchronously.
+	Stop() error
+
+	// OnConfigureDoneCalled reports whether the app called OnConfigureDone.
+	OnConfigureDoneCalled() bool
+
+	// OnInitDoneCalled reports whether the app called OnInitDone.
+	OnInitDoneCalled() bool
+
+	// OnDeinitDoneCalled reports whether the app called OnDeinitDone.
+	OnDeinitDoneCalled() bool
+
+	// TenEnv returns the ten env handed to the app, so a test can inspect the
+	// properties the app set during its lifecycle callbacks.
+	TenEnv() TenEnv
+}
+
+type appTester struct {
+	iApp IApp
+	env  *appTesterTenEnv
+}
+
+// NewAppTester creates a tester for the given app implementation.
+func NewAppTester(iApp IApp) (AppTester, error) {
+	if iApp == nil {
+		return nil, NewTenError(
+			ErrorCodeInvalidArgument,
+			"iApp is required.",
+		)
+	}
+
+	return &appTester{
+		iApp: iApp,
+		env:  newAppTesterTenEnv(),
+	}, nil
+}
+
+func (p *appTester) InjectPropertyFromJSONBytes(value []byte) error {
+	return p.env.InitPropertyFromJSONBytes(value)
+}
+
+func (p *appTester) Run() error {
+	p.iApp.OnConfigure(p.env)
+	if !p.env.onConfigureDoneCalled() {
+		return NewTenError(
+			ErrorCodeGeneric,
+			"the app did not call OnConfigureDone in OnConfigure",
+		)
+	}
+
+	p.iApp.OnInit(p.env)
+	if !p.env.onInitDoneCalled() {
+		return NewTenError(
+			ErrorCodeGeneric,
+			"the app did not call OnInitDone in OnInit",
+		)
+	}
+
+	return nil
+}
+
+func (p *appTester) Stop() error {
+	p.iApp.OnDeinit(p.env)
+	if !p.env.onDeinitDoneCalled() {
+		return NewTenError(
+			ErrorCodeGeneric,
+			"the app did not call OnDeinitDone in OnDeinit",
+		)
+	}
+
+	return nil
+}
+
+func (p *appTester) OnConfigureDoneCalled() bool {
+	return p.env.onConfigureDoneCalled()
+}
+
+func (p *appTester) OnInitDoneCalled() bool {
+	return p.env.onInitDoneCalled()
+}
+
+func (p *appTester) OnDeinitDoneCalled() bool {
+	return p.env.onDeinitDoneCalled()
+}
+
+func (p *appTester) TenEnv() TenEnv {
+	return p.env
+}
+
+// appTesterTenEnv is a pure GO ten env backed by an in-memory property tree.
+// Messaging APIs are not available, as there is no graph behind the tester.
+type appTesterTenEnv struct {
+	mu     sync.Mutex
+	props  map[string]any
+	locals map[string]any
+
+	configureDone bool
+	initDone      bool
+	deinitDone    bool
+
+	// clock overrides the time source of Now during tests; nil means real
+	// time. See SetClock.
+	clock Clock
+
+	// goroutines mirrors the runtime env's tracked-goroutine barrier, so
+	// code under test can use Go/GoStopping against the harness too.
+	goroutines goroutineTracker
+}
+
+var _ TenEnv = new(appTesterTenEnv)
+
+func newAppTesterTenEnv() *appTesterTenEnv {
+	return &appTesterTenEnv{
+		props: make(map[string]any),
+	}
+}
+
+func (p *appTesterTenEnv) onConfigureDoneCalled() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.configureDone
+}
+
+func (p *appTesterTenEnv) onInitDoneCalled() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.initDone
+}
+
+func (p *appTesterTenEnv) onDeinitDoneCalled() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.deinitDone
+}
+
+func errAppTesterNotSupported(api string) error {
+	return NewTenError(
+		ErrorCodeGeneric,
+		api+" is not supported in the app tester, as there is no graph",
+	)
+}
+
+func (p *appTesterTenEnv) SendCmd(
+	cmd Cmd,
+	handler ResultHandler,
+	options ...SendCmdOption,
+) error {
+	return errAppTesterNotSupported("SendCmd")
+}
+
+func (p *appTesterTenEnv) SendCmdEx(cmd Cmd, handler ResultHandler) error {
+	return errAppTesterNotSupported("SendCmdEx")
+}
+
+func (p *appTesterTenEnv) SendCmdExCtx(
+	cmd Cmd,
+	userData any,
+	handler ResultHandlerWithCtx,
+) error {
+	return errAppTesterNotSupported("SendCmdExCtx")
+}
+
+func (p *appTesterTenEnv) SendCmdFinal(cmd Cmd) (CmdResult, error) {
+	return nil, errAppTesterNotSupported("SendCmdFinal")
+}
+
+func (p *appTesterTenEnv) SetReady(ready bool) error {
+	return errAppTesterNotSupported("SetReady")
+}
+
+func (p *appTesterTenEnv) SetPanicHandler(handler PanicHandler) error {
+	return errAppTesterNotSupported("SetPanicHandler")
+}
+
+func (p *appTesterTenEnv) GetManifest() (*Manifest, error) {
+	return nil, errAppTesterNotSupported("GetManifest")
+}
+
+func (p *appTesterTenEnv) GetConnectedExtensions(
+	msgName string,
+) ([]string, error) {
+	return nil, errAppTesterNotSupported("GetConnectedExtensions")
+}
+
+func (p *appTesterTenEnv) TrySendCmd(
+	cmd Cmd,
+	handler ResultHandler,
+) error {
+	return errAppTesterNotSupported("TrySendCmd")
+}
+
+func (p *appTesterTenEnv) SetMaxInFlightCmds(limit int) {
+}
+
+// PendingResultCount is always zero on the app tester: sending cmds is not
+// supported here, so no result handler can ever be pending.
+func (p *appTesterTenEnv) PendingResultCount() int {
+	return 0
+}
+
+func (p *appTesterTenEnv) SetOrphanedResultHandler(
+	handler OrphanedResultHandler,
+) {
+}
+
+func (p *appTesterTenEnv) ListGraphs() ([]GraphInfo, error) {
+	return nil, errAppTesterNotSupported("ListGraphs")
+}
+
+func (p *appTesterTenEnv) SetLogFile(path string) error {
+	return errAppTesterNotSupported("SetLogFile")
+}
+
+func (p *appTesterTenEnv) GetBaseDir() (string, error) {
+	return "", errAppTesterNotSupported("GetBaseDir")
+}
+
+func (p *appTesterTenEnv) Now() time.Time {
+	if clock := p.currentClock(); clock != nil {
+		return clock.Now()
+	}
+
+	// There is no runtime clock behind the app tester; the Go clock is the
+	// only clock in play, so the two cannot drift apart here.
+	return time.Now()
+}
+
+func (p *appTesterTenEnv) SendJSON(
+	name string,
+	propsJSON string,
+	handler ResultHandler,
+) error {
+	return errAppTesterNotSupported("SendJSON")
+}
+
+func (p *appTesterTenEnv) SendCmdWithCancel(
+	cmd Cmd,
+	handler ResultHandler,
+) (CancelFunc, error) {
+	return nil, errAppTesterNotSupported("SendCmdWithCancel")
+}
+
+func (p *appTesterTenEnv) AcknowledgeCancelCmd(cancelCmd Cmd) error {
+	return errAppTesterNotSupported("AcknowledgeCancelCmd")
+}
+
+func (p *appTesterTenEnv) IsCmdCanceled(cmd Cmd) bool {
+	return false
+}
+
+func (p *appTesterTenEnv) SendCmdRetry(
+	cmd Cmd,
+	policy RetryPolicy,
+	handler ResultHandler,
+) error {
+	return errAppTesterNotSupported("SendCmdRetry")
+}
+
+func (p *appTesterTenEnv) SendToSelf(cmd Cmd, handler ResultHandler) error {
+	return errAppTesterNotSupported("SendToSelf")
+}
+
+func (p *appTesterTenEnv) SendCmdFanOut(
+	cmd Cmd,
+	dests []Loc,
+	handler FanOutResultHandler,
+) error {
+	return errAppTesterNotSupported("SendCmdFanOut")
+}
+
+func (p *appTesterTenEnv) UseOutboundMiddleware(
+	middleware OutboundMiddleware,
+) error {
+	return errAppTesterNotSupported("UseOutboundMiddleware")
+}
+
+func (p *appTesterTenEnv) UseInboundMiddleware(
+	middleware InboundMiddleware,
+) error {
+	return errAppTesterNotSupported("UseInboundMiddleware")
+}
+
+func (p *appTesterTenEnv) SendData(data Data, handler ErrorHandler) error {
+	return errAppTesterNotSupported("SendData")
+}
+
+func (p *appTesterTenEnv) SendDataWithResult(
+	data Data,
+	handler DataResultHandler,
+) error {
+	return errAppTesterNotSupported("SendDataWithResult")
+}
+
+func (p *appTesterTenEnv) SendVideoFrame(
+	videoFrame VideoFrame,
+	handler ErrorHandler,
+) error {
+	return errAppTesterNotSupported("SendVideoFrame")
+}
+
+func (p *appTesterTenEnv) SendAudioFrame(
+	audioFrame AudioFrame,
+	handler ErrorHandler,
+) error {
+	return errAppTesterNotSupported("SendAudioFrame")
+}
+
+func (p *appTesterTenEnv) ReturnResult(
+	result CmdResult,
+	handler ErrorHandler,
+) error {
+	return errAppTesterNotSupported("ReturnResult")
+}
+
+func (p *appTesterTenEnv) CloseApp() error {
+	return errAppTesterNotSupported("CloseApp")
+}
+
+func (p *appTesterTenEnv) OnConfigureDone() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.configureDone = true
+	return nil
+}
+
+func (p *appTesterTenEnv) OnInitDone() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.initDone = true
+	return nil
+}
+
+func (p *appTesterTenEnv) OnStartDone() error {
+	return nil
+}
+
+func (p *appTesterTenEnv) OnStopDone() error {
+	p.goroutines.shutdown()
+	return nil
+}
+
+func (p *appTesterTenEnv) Go(fn func(tenEnv TenEnv)) error {
+	if fn == nil {
+		return NewTenError(
+			ErrorCodeInvalidArgument,
+			"fn is required.",
+		)
+	}
+
+	if !p.goroutines.begin() {
+		return NewTenError(
+			ErrorCodeGeneric,
+			"the tester env is stopping; no new tracked goroutines.",
+		)
+	}
+
+	go func() {
+		defer p.goroutines.wg.Done()
+		fn(p)
+	}()
+
+	return nil
+}
+
+func (p *appTesterTenEnv) GoStopping() <-chan struct{} {
+	return p.goroutines.stopChan()
+}
+
+func (p *appTesterTenEnv) OnDeinitDone() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.deinitDone = true
+	return nil
+}
+
+func (p *appTesterTenEnv) OnCreateInstanceDone(
+	instance any,
+	context uintptr,
+) error {
+	return errAppTesterNotSupported("OnCreateInstanceDone")
+}
+
+func (p *appTesterTenEnv) InitPropertyFromJSONBytes(value []byte) error {
+	var props map[string]any
+	if err := json.Unmarshal(value, &props); err != nil {
+		return NewTenError(ErrorCodeInvalidJSON, err.Error())
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.props = props
+	return nil
+}
+
+func (p *appTesterTenEnv) InitPropertyFromJSONBytesInterpolated(
+	value []byte,
+) error {
+	value, err := interpolateEnvInJSONBytes(value)
+	if err != nil {
+		return err
+	}
+
+	return p.InitPropertyFromJSONBytes(value)
+}
+
+func (p *appTesterTenEnv) InitPropertyFromJSONBytesWithOverrides(
+	value []byte,
+	overrides map[string]any,
+) error {
+	if err := p.InitPropertyFromJSONBytes(value); err != nil {
+		return err
+	}
+
+	return applyPropertyOverrides(p.SetProperty, overrides)
+}
+
+// getProperty resolves a dotted path (ex: "ten.uri") in the property tree.
+func (p *appTesterTenEnv) getProperty(path string) (any, error) {
+	if len(path) == 0 {
+		return nil, NewTenError(
+			ErrorCodeInvalidArgument,
+			"property path is required",
+		)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var current any = p.props
+	for _, segment := range strings.Split(path, ".") {
+		obj, ok := current.(map[string]any)
+		if !ok {
+			return nil, NewTenError(ErrorCodeGeneric, "Not found.")
+		}
+
+		current, ok = obj[segment]
+		if !ok {
+			return nil, NewTenError(ErrorCodeGeneric, "Not found.")
+		}
+	}
+
+	return current, nil
+}
+
+func (p *appTesterTenEnv) SetProperty(path string, value any) error {
+	if len(path) == 0 {
+		return NewTenError(
+			ErrorCodeInvalidArgument,
+			"the property path is required",
+		)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	current := p.props
+	segments := strings.Split(path, ".")
+	for _, segment := range segments[:len(segments)-1] {
+		next, ok := current[segment].(map[string]any)
+		if !ok {
+			next = make(map[string]any)
+			current[segment] = next
+		}
+		current = next
+	}
+
+	current[segments[len(segments)-1]] = value
+	return nil
+}
+
+func (p *appTesterTenEnv) SetPropertyString(path string, value string) error {
+	return p.SetProperty(path, value)
+}
+
+func (p *appTesterTenEnv) SetPropertyBytes(path string, value []byte) error {
+	return p.SetProperty(path, value)
+}
+
+func (p *appTesterTenEnv) SetPropertyFromJSONBytes(
+	path string,
+	value []byte,
+) error {
+	var parsed any
+	if err := json.Unmarshal(value, &parsed); err != nil {
+		return NewTenError(ErrorCodeInvalidJSON, err.Error())
+	}
+
+	return p.SetProperty(path, parsed)
+}
+
+// appTesterPropToInt64 converts a property value to int64. Values coming from
+// an injected json property tree are float64, so integral floats are accepted.
+func appTesterPropToInt64(value any) (int64, error) {
+	switch v := value.(type) {
+	case int8:
+		return int64(v), nil
+	case int16:
+		return int64(v), nil
+	case int32:
+		return int64(v), nil
+	case int64:
+		return v, nil
+	case int:
+		return int64(v), nil
+	case uint8:
+		return int64(v), nil
+	case uint16:
+		return int64(v), nil
+	case uint32:
+		return int64(v), nil
+	case float64:
+		if v == float64(int64(v)) {
+			return int64(v), nil
+		}
+	}
+
+	return 0, NewTenError(
+		ErrorCodeInvalidType,
+		fmt.Sprintf("expected: int, actual: %T", value),
+	)
+}
+
+func appTesterPropToFloat64(value any) (float64, error) {
+	switch v := value.(type) {
+	case float32:
+		return float64(v), nil
+	case float64:
+		return v, nil
+	}
+
+	if i, err := appTesterPropToInt64(value); err == nil {
+		return float64(i), nil
+	}
+
+	return 0, NewTenError(
+		ErrorCodeInvalidType,
+		fmt.Sprintf("expected: float, actual: %T", value),
+	)
+}
+
+func (p *appTesterTenEnv) GetPropertyInt8(path string) (int8, error) {
+	v, err := p.getPropertyInt64(path)
+	return int8(v), err
+}
+
+func (p *appTesterTenEnv) GetPropertyInt16(path string) (int16, error) {
+	v, err := p.getPropertyInt64(path)
+	return int16(v), err
+}
+
+func (p *appTesterTenEnv) GetPropertyInt32(path string) (int32, error) {
+	v, err := p.getPropertyInt64(path)
+	return int32(v), err
+}
+
+func (p *appTesterTenEnv) GetPropertyInt64(path string) (int64, error) {
+	return p.getPropertyInt64(path)
+}
+
+func (p *appTesterTenEnv) getPropertyInt64(path string) (int64, error) {
+	value, err := p.getProperty(path)
+	if err != nil {
+		return 0, err
+	}
+
+	return appTesterPropToInt64(value)
+}
+
+func (p *appTesterTenEnv) GetPropertyUint8(path string) (uint8, error) {
+	v, err := p.getPropertyInt64(path)
+	return uint8(v), err
+}
+
+func (p *appTesterTenEnv) GetPropertyUint16(path string) (uint16, error) {
+	v, err := p.getPropertyInt64(path)
+	return uint16(v), err
+}
+
+func (p *appTesterTenEnv) GetPropertyUint32(path string) (uint32, error) {
+	v, err := p.getPropertyInt64(path)
+	return uint32(v), err
+}
+
+func (p *appTesterTenEnv) GetPropertyUint64(path string) (uint64, error) {
+	v, err := p.getPropertyInt64(path)
+	return uint64(v), err
+}
+
+func (p *appTesterTenEnv) GetPropertyFloat32(path string) (float32, error) {
+	value, err := p.getProperty(path)
+	if err != nil {
+		return 0, err
+	}
+
+	v, err := appTesterPropToFloat64(value)
+	return float32(v), err
+}
+
+func (p *appTesterTenEnv) GetPropertyFloat64(path string) (float64, error) {
+	value, err := p.getProperty(path)
+	if err != nil {
+		return 0, err
+	}
+
+	return appTesterPropToFloat64(value)
+}
+
+func (p *appTesterTenEnv) GetPropertyBool(path string) (bool, error) {
+	value, err := p.getProperty(path)
+	if err != nil {
+		return false, err
+	}
+
+	v, ok := value.(bool)
+	if !ok {
+		return false, NewTenError(
+			ErrorCodeInvalidType,
+			fmt.Sprintf("expected: bool, actual: %T", value),
+		)
+	}
+
+	return v, nil
+}
+
+func (p *appTesterTenEnv) GetPropertyPtr(path string) (any, error) {
+	return p.getProperty(path)
+}
+
+func (p *appTesterTenEnv) SetPropertyPtr(path string, value any) error {
+	if value == nil {
+		return NewTenError(
+			ErrorCodeInvalidArgument,
+			"a nil value can not be stored as a pointer property.",
+		)
+	}
+
+	return p.SetProperty(path, value)
+}
+
+func (p *appTesterTenEnv) GetPropertyString(path string) (string, error) {
+	value, err := p.getProperty(path)
+	if err != nil {
+		return "", err
+	}
+
+	v, ok := value.(string)
+	if !ok {
+		return "", NewTenError(
+			ErrorCodeInvalidType,
+			fmt.Sprintf("expected: string, actual: %T", value),
+		)
+	}
+
+	return v, nil
+}
+
+func (p *appTesterTenEnv) GetPropertyBytes(path string) ([]byte, error) {
+	value, err := p.getProperty(path)
+	if err != nil {
+		return nil, err
+	}
+
+	v, ok := value.([]byte)
+	if !ok {
+		return nil, NewTenError(
+			ErrorCodeInvalidType,
+			fmt.Sprintf("expected: []byte, actual: %T", value),
+		)
+	}
+
+	return v, nil
+}
+
+func (p *appTesterTenEnv) GetPropertyToJSONBytes(path string) ([]byte, error) {
+	var value any
+	if len(path) == 0 {
+		p.mu.Lock()
+		value = p.props
+		p.mu.Unlock()
+	} else {
+		var err error
+		value, err = p.getProperty(path)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	jsonBytes, err := json.Marshal(value)
+	if err != nil {
+		return nil, NewTenError(ErrorCodeInvalidJSON, err.Error())
+	}
+
+	return jsonBytes, nil
+}
+
+func (p *appTesterTenEnv) GetPropertyType(path string) (ValueType, error) {
+	value, err := p.getProperty(path)
+	if err != nil {
+		return valueTypeInvalid, err
+	}
+
+	// The app tester stores properties as plain Go values, so objects and
+	// arrays appear as maps and slices rather than runtime value handles.
+	switch value.(type) {
+	case map[string]any:
+		return ValueTypeObject, nil
+	case []any:
+		return ValueTypeArray, nil
+	}
+
+	return getPropType(value).toValueType(), nil
+}
+
+func (p *appTesterTenEnv) GetPropertyInt64Array(path string) ([]int64, error) {
+	jsonBytes, err := p.GetPropertyToJSONBytes(path)
+	if err != nil {
+		return nil, err
+	}
+
+	numbers, err := decodePropertyNumberArray(jsonBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]int64, len(numbers))
+	for i, number := range numbers {
+		value, err := number.Int64()
+		if err != nil {
+			return nil, NewTenError(
+				ErrorCodeInvalidType,
+				fmt.Sprintf(
+					"expected: int64 at index %d, actual: %s",
+					i,
+					number,
+				),
+			)
+		}
+
+		values[i] = value
+	}
+
+	return values, nil
+}
+
+func (p *appTesterTenEnv) SetPropertyInt64Array(
+	path string,
+	value []int64,
+) error {
+	jsonBytes, err := json.Marshal(value)
+	if err != nil {
+		return NewTenError(ErrorCodeInvalidJSON, err.Error())
+	}
+
+	return p.SetPropertyFromJSONBytes(path, jsonBytes)
+}
+
+func (p *appTesterTenEnv) GetPropertyFloat64Array(
+	path string,
+) ([]float64, error) {
+	jsonBytes, err := p.GetPropertyToJSONBytes(path)
+	if err != nil {
+		return nil, err
+	}
+
+	numbers, err := decodePropertyNumberArray(jsonBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]float64, len(numbers))
+	for i, number := range numbers {
+		value, err := number.Float64()
+		if err != nil {
+			return nil, NewTenError(
+				ErrorCodeInvalidType,
+				fmt.Sprintf(
+					"expected: float64 at index %d, actual: %s",
+					i,
+					number,
+				),
+			)
+		}
+
+		values[i] = value
+	}
+
+	return values, nil
+}
+
+func (p *appTesterTenEnv) SetPropertyFloat64Array(
+	path string,
+	value []float64,
+) error {
+	jsonBytes, err := json.Marshal(value)
+	if err != nil {
+		return NewTenError(ErrorCodeInvalidJSON, err.Error())
+	}
+
+	return p.SetPropertyFromJSONBytes(path, jsonBytes)
+}
+
+func (p *appTesterTenEnv) IsUnderTest() bool {
+	return true
+}
+
+func (p *appTesterTenEnv) StopReason() StopReason {
+	return StopReasonNormal
+}
+
+func (p *appTesterTenEnv) SetLocal(key string, value any) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.locals == nil {
+		p.locals = make(map[string]any)
+	}
+
+	p.locals[key] = value
+}
+
+func (p *appTesterTenEnv) GetLocal(key string) (any, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	value, ok := p.locals[key]
+	return value, ok
+}
+
+func (p *appTesterTenEnv) LogDebug(msg string) error {
+	return p.Log(LogLevelDebug, msg, nil, nil, nil)
+}
+
+func (p *appTesterTenEnv) LogInfo(msg string) error {
+	return p.Log(LogLevelInfo, msg, nil, nil, nil)
+}
+
+func (p *appTesterTenEnv) LogWarn(msg string) error {
+	return p.Log(LogLevelWarn, msg, nil, nil, nil)
+}
+
+func (p *appTesterTenEnv) LogError(msg string) error {
+	return p.Log(LogLevelError, msg, nil, nil, nil)
+}
+
+func (p *appTesterTenEnv) LogErr(msg string, err error) error {
+	if err != nil {
+		msg = msg + ": " + err.Error()
+	}
+	return p.Log(LogLevelError, msg, nil, nil, nil)
+}
+
+func (p *appTesterTenEnv) Log(
+	level LogLevel,
+	msg string,
+	category *string,
+	fields *Value,
+	option *LogOption,
+) error {
+	// The app tester has no runtime logger behind it; the logs are dropped on
+	// purpose to keep the tested app code silent in unit tests.
+	return nil
+}
@@ -0,0 +1,70 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"testing"
+)
+
+type testApp struct {
+	DefaultApp
+
+	uri string
+}
+
+func (p *testApp) OnInit(tenEnv TenEnv) {
+	p.uri, _ = tenEnv.GetPropertyString("ten.uri")
+	tenEnv.OnInitDone()
+}
+
+func TestAppTesterLifecycle(t *testing.T) {
+	app := &testApp{}
+
+	tester, err := NewAppTester(app)
+	if err != nil {
+		t.FailNow()
+	}
+
+	err = tester.InjectPropertyFromJSONBytes(
+		[]byte(`{"ten": {"uri": "msgpack://127.0.0.1:8001/"}}`),
+	)
+	if err != nil {
+		t.FailNow()
+	}
+
+	if err := tester.Run(); err != nil {
+		t.FailNow()
+	}
+
+	if !tester.OnConfigureDoneCalled() || !tester.OnInitDoneCalled() {
+		t.FailNow()
+	}
+
+	if app.uri != "msgpack://127.0.0.1:8001/" {
+		t.FailNow()
+	}
+
+	if err := tester.Stop(); err != nil {
+		t.FailNow()
+	}
+
+	if !tester.OnDeinitDoneCalled() {
+		t.FailNow()
+	}
+}
+
+func TestAppTesterInjectInvalidProperty(t *testing.T) {
+	tester, err := NewAppTester(&testApp{})
+	if err != nil {
+		t.FailNow()
+	}
+
+	if err := tester.InjectPropertyFromJSONBytes([]byte("{")); err == nil {
+		t.FailNow()
+	}
+}
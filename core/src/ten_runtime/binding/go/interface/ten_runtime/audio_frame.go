@@ -85,7 +85,7 @@ func NewAudioFrame(audioFrameName string) (AudioFrame, error) {
 	if len(audioFrameName) == 0 {
 		return nil, NewTenError(
 			ErrorCodeInvalidArgument,
-			"audio frame name is required",
+			"audio frame name is required.",
 		)
 	}
 
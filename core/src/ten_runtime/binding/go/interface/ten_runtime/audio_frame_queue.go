@@ -0,0 +1,201 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// OverflowPolicy selects what an AudioFrameQueue does when a producer is
+// faster than the downstream consumer and the queue is full.
+type OverflowPolicy int
+
+const (
+	// OverflowBlock makes Send block until the consumer catches up. Frame
+	// order and completeness are preserved at the cost of producer latency.
+	OverflowBlock OverflowPolicy = iota
+
+	// OverflowDropOldest evicts the oldest queued frame to admit the new
+	// one, keeping the stream as fresh as possible for realtime pipelines.
+	OverflowDropOldest
+
+	// OverflowDropNewest discards the incoming frame, keeping what is
+	// already queued.
+	OverflowDropNewest
+)
+
+// AudioFrameQueue adds flow control between an audio frame producer and the
+// downstream consumer. Frames pass through a bounded queue drained one at a
+// time: the next frame is only sent after the runtime has finished handing
+// off the previous one, so a slow consumer shows up as queue depth instead
+// of unbounded memory growth. The overflow policy decides whether a full
+// queue blocks the producer or drops frames; dropped frames are counted for
+// observability.
+type AudioFrameQueue struct {
+	send     func(audioFrame AudioFrame, handler ErrorHandler) error
+	capacity int
+	policy   OverflowPolicy
+
+	mu     sync.Mutex
+	cond   *sync.Cond
+	queue  []AudioFrame
+	closed bool
+
+	dropped atomic.Uint64
+}
+
+// NewAudioFrameQueue creates a queue that forwards frames to the given ten
+// env. Capacity must be positive. Close the queue when the stream ends so
+// its drain goroutine stops.
+func NewAudioFrameQueue(
+	tenEnv TenEnv,
+	capacity int,
+	policy OverflowPolicy,
+) (*AudioFrameQueue, error) {
+	if tenEnv == nil {
+		return nil, NewTenError(
+			ErrorCodeInvalidArgument,
+			"tenEnv is required.",
+		)
+	}
+
+	return newAudioFrameQueue(tenEnv.SendAudioFrame, capacity, policy)
+}
+
+// newAudioFrameQueue is the implementation behind NewAudioFrameQueue, with
+// the send function injected so the queueing behavior is testable without a
+// runtime.
+func newAudioFrameQueue(
+	send func(audioFrame AudioFrame, handler ErrorHandler) error,
+	capacity int,
+	policy OverflowPolicy,
+) (*AudioFrameQueue, error) {
+	if capacity <= 0 {
+		return nil, NewTenError(
+			ErrorCodeInvalidArgument,
+			"capacity must be positive.",
+		)
+	}
+
+	q := &AudioFrameQueue{
+		send:     send,
+		capacity: capacity,
+		policy:   policy,
+	}
+	q.cond = sync.NewCond(&q.mu)
+
+	go q.drainLoop()
+
+	return q, nil
+}
+
+// Send enqueues one frame. When the queue is full, the overflow policy
+// applies: OverflowBlock waits for space, the drop policies return nil
+// immediately and count the discarded frame. Send fails after Close.
+func (p *AudioFrameQueue) Send(audioFrame AudioFrame) error {
+	if audioFrame == nil {
+		return NewTenError(
+			ErrorCodeInvalidArgument,
+			"audioFrame is required.",
+		)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for len(p.queue) >= p.capacity {
+		if p.closed {
+			break
+		}
+
+		switch p.policy {
+		case OverflowBlock:
+			p.cond.Wait()
+			continue
+		case OverflowDropOldest:
+			p.queue = p.queue[1:]
+			p.dropped.Add(1)
+		case OverflowDropNewest:
+			p.dropped.Add(1)
+			return nil
+		}
+	}
+
+	if p.closed {
+		return NewTenError(
+			ErrorCodeGeneric,
+			"the audio frame queue is closed.",
+		)
+	}
+
+	p.queue = append(p.queue, audioFrame)
+	p.cond.Broadcast()
+
+	return nil
+}
+
+// Dropped returns how many frames the overflow policy has discarded so far.
+func (p *AudioFrameQueue) Dropped() uint64 {
+	return p.dropped.Load()
+}
+
+// Depth returns the number of frames currently queued, a direct measure of
+// how far the consumer is behind.
+func (p *AudioFrameQueue) Depth() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return len(p.queue)
+}
+
+// Close stops the drain goroutine and unblocks any producer waiting in
+// Send. Frames still queued are discarded and counted as dropped.
+func (p *AudioFrameQueue) Close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.closed {
+		return
+	}
+
+	p.closed = true
+	p.dropped.Add(uint64(len(p.queue)))
+	p.queue = nil
+	p.cond.Broadcast()
+}
+
+// drainLoop forwards queued frames one at a time, waiting for the runtime
+// to finish handing off each frame before sending the next; that wait is
+// what turns consumer lag into queue depth.
+func (p *AudioFrameQueue) drainLoop() {
+	for {
+		p.mu.Lock()
+		for len(p.queue) == 0 && !p.closed {
+			p.cond.Wait()
+		}
+
+		if p.closed {
+			p.mu.Unlock()
+			return
+		}
+
+		frame := p.queue[0]
+		p.queue = p.queue[1:]
+		p.cond.Broadcast()
+		p.mu.Unlock()
+
+		handedOff := make(chan struct{})
+		err := p.send(frame, func(tenEnv TenEnv, err error) {
+			close(handedOff)
+		})
+		if err == nil {
+			<-handedOff
+		}
+	}
+}
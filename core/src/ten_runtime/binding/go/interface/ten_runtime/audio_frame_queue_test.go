@@ -0,0 +1,162 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+// blockedSender is a send function whose consumer only advances when the
+// test releases it, to simulate a lagging downstream extension.
+type blockedSender struct {
+	mu       sync.Mutex
+	pending  []ErrorHandler
+	received int
+}
+
+func (p *blockedSender) send(
+	audioFrame AudioFrame,
+	handler ErrorHandler,
+) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.received++
+	p.pending = append(p.pending, handler)
+	return nil
+}
+
+func (p *blockedSender) release() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, handler := range p.pending {
+		handler(nil, nil)
+	}
+	p.pending = nil
+}
+
+// waitFor polls until cond holds or the deadline passes.
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		if cond() {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	t.FailNow()
+}
+
+func TestAudioFrameQueueDropOldest(t *testing.T) {
+	sender := &blockedSender{}
+	q, err := newAudioFrameQueue(sender.send, 2, OverflowDropOldest)
+	if err != nil {
+		t.FailNow()
+	}
+	defer q.Close()
+
+	// The drain loop picks up one frame and blocks on the consumer; the
+	// queue then holds at most two more.
+	for i := 0; i < 5; i++ {
+		if err := q.Send(&audioFrame{}); err != nil {
+			t.FailNow()
+		}
+	}
+
+	waitFor(t, func() bool { return q.Dropped() >= 2 })
+	if q.Depth() > 2 {
+		t.FailNow()
+	}
+}
+
+func TestAudioFrameQueueDropNewest(t *testing.T) {
+	sender := &blockedSender{}
+	q, err := newAudioFrameQueue(sender.send, 1, OverflowDropNewest)
+	if err != nil {
+		t.FailNow()
+	}
+	defer q.Close()
+
+	for i := 0; i < 4; i++ {
+		if err := q.Send(&audioFrame{}); err != nil {
+			t.FailNow()
+		}
+	}
+
+	waitFor(t, func() bool { return q.Dropped() >= 2 })
+}
+
+func TestAudioFrameQueueBlockReleases(t *testing.T) {
+	sender := &blockedSender{}
+	q, err := newAudioFrameQueue(sender.send, 1, OverflowBlock)
+	if err != nil {
+		t.FailNow()
+	}
+	defer q.Close()
+
+	for i := 0; i < 2; i++ {
+		if err := q.Send(&audioFrame{}); err != nil {
+			t.FailNow()
+		}
+	}
+
+	// The queue is full; the next Send blocks until the consumer advances.
+	sent := make(chan struct{})
+	go func() {
+		q.Send(&audioFrame{})
+		close(sent)
+	}()
+
+	select {
+	case <-sent:
+		t.FailNow()
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	sender.release()
+
+	select {
+	case <-sent:
+	case <-time.After(time.Second):
+		t.FailNow()
+	}
+
+	if q.Dropped() != 0 {
+		t.FailNow()
+	}
+}
+
+func TestAudioFrameQueueCloseUnblocksAndFailsSend(t *testing.T) {
+	sender := &blockedSender{}
+	q, err := newAudioFrameQueue(sender.send, 1, OverflowBlock)
+	if err != nil {
+		t.FailNow()
+	}
+
+	q.Close()
+
+	if err := q.Send(&audioFrame{}); err == nil {
+		t.FailNow()
+	}
+}
+
+func TestAudioFrameQueueRejectsBadCapacity(t *testing.T) {
+	if _, err := newAudioFrameQueue(
+		(&blockedSender{}).send,
+		0,
+		OverflowBlock,
+	); err == nil {
+		t.FailNow()
+	}
+}
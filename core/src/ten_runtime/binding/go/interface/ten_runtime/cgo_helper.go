@@ -13,6 +13,9 @@ import (
 
 type iRateLimiter interface {
 	acquire()
+	// tryAcquire takes a token only if one is free, so callers can fail
+	// fast instead of blocking. See TrySendCmd.
+	tryAcquire() bool
 	release()
 }
 
@@ -30,6 +33,15 @@ func (tbl *tokenBucketLimiter) acquire() {
 	tbl.tokens <- struct{}{}
 }
 
+func (tbl *tokenBucketLimiter) tryAcquire() bool {
+	select {
+	case tbl.tokens <- struct{}{}:
+		return true
+	default:
+		return false
+	}
+}
+
 func (tbl *tokenBucketLimiter) release() {
 	<-tbl.tokens
 }
@@ -162,6 +174,8 @@ var limiter iRateLimiter = newTokenBucketLimiter(defaultTokenBuckets)
 // concurrent cgo calls. But we **must not** perform any blocking operations
 // inside the `fn` passed to it.
 func withCGOLimiter(fn func() error) error {
+	bindingActivity.Add(1)
+
 	limiter.acquire()
 	defer limiter.release()
 
@@ -172,6 +186,8 @@ func withCGOLimiter(fn func() error) error {
 // Executes the function surrounded with a rate limiter. The fn will be blocked
 // if the rate limit has exceeded, otherwise fn will be executed directly.
 func withCGOLimiterHasReturnValue[T any](fn func() (T, error)) (T, error) {
+	bindingActivity.Add(1)
+
 	limiter.acquire()
 	defer limiter.release()
 
@@ -11,12 +11,27 @@ package ten_runtime
 import "C"
 
 import (
+	"sync/atomic"
 	"unsafe"
 )
 
 // CmdBase is the base interface for the command and the result command.
 type CmdBase interface {
 	Msg
+
+	// GetCmdID returns the command id assigned by TEN runtime, which is the id
+	// the runtime uses internally to correlate a cmd result with its cmd. A
+	// cmd result carries the same cmd id as the cmd it responds to, so the id
+	// can be used to trace a request/response pair across logs.
+	GetCmdID() (string, error)
+
+	// ValidateAgainstSchema validates the properties of the cmd against a
+	// json schema, collecting every violation into one error.
+	ValidateAgainstSchema(schemaJSON []byte) error
+
+	// RangeProperties iterates all top-level properties of the cmd without
+	// materializing a full map, stopping when fn returns false.
+	RangeProperties(fn func(key string, value any) bool) error
 }
 
 // Cmd is the interface for the command.
@@ -24,6 +39,14 @@ type Cmd interface {
 	CmdBase
 
 	Clone() (Cmd, error)
+
+	// SetBuf attaches an opaque byte payload to the cmd, carried as a buffer
+	// property, so a cmd can ship a binary body alongside (or instead of)
+	// structured properties.
+	SetBuf(buf []byte) error
+
+	// GetBuf returns a copy of the byte payload attached with SetBuf.
+	GetBuf() ([]byte, error)
 }
 
 // NewCmd creates a custom cmd which is intended to be sent to another
@@ -108,6 +131,21 @@ func NewCmd(cmdName string) (Cmd, error) {
 
 type cmd struct {
 	*msg
+
+	// resultReturned records that a final result has already been returned
+	// for this command, so a second ReturnResult can be rejected instead of
+	// corrupting runtime state.
+	resultReturned atomic.Bool
+
+	// resultSeq numbers the results returned for this command, so streamed
+	// results carry their send order. See ResultSequencePropertyName.
+	resultSeq atomic.Uint64
+}
+
+// nextResultSeq hands out the sequence number for the next result returned
+// for this command, starting at 1.
+func (p *cmd) nextResultSeq() uint64 {
+	return p.resultSeq.Add(1)
 }
 
 func newCmd(bridge C.uintptr_t) *cmd {
@@ -116,6 +154,22 @@ func newCmd(bridge C.uintptr_t) *cmd {
 	}
 }
 
+func (p *cmd) GetCmdID() (string, error) {
+	defer p.keepAlive()
+
+	var cmdID *C.char
+	err := withCGOLimiter(func() error {
+		apiStatus := C.ten_go_cmd_get_cmd_id(p.cPtr, &cmdID)
+		return withCGoError(&apiStatus)
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	return C.GoString(cmdID), nil
+}
+
 func (p *cmd) Clone() (Cmd, error) {
 	var bridge C.uintptr_t
 	err := withCGOLimiter(func() error {
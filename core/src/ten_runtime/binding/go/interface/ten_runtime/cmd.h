@@ -34,6 +34,15 @@ ten_go_error_t ten_go_cmd_create_cmd(const void *cmd_name, int cmd_name_len,
 ten_go_error_t ten_go_cmd_clone(uintptr_t bridge_addr,
                                 uintptr_t *cloned_bridge);
 
+/**
+ * @brief Get the command id of a cmd or cmd result, which is the id TEN
+ * runtime uses internally to correlate a cmd result with its cmd. The returned
+ * string is owned by the underlying message, do not free it, and do not read
+ * it after the blocking cgo call.
+ */
+ten_go_error_t ten_go_cmd_get_cmd_id(uintptr_t bridge_addr,
+                                     const char **cmd_id);
+
 uintptr_t ten_go_cmd_create_cmd_result(int status_code, uintptr_t target_cmd);
 
 ten_go_error_t ten_go_cmd_result_clone(uintptr_t bridge_addr,
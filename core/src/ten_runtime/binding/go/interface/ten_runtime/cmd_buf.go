@@ -0,0 +1,24 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+// CmdBufPropertyName is the well-known buffer property carrying the binary
+// payload of a cmd set with SetBuf.
+const CmdBufPropertyName = "ten:buf"
+
+// SetBuf attaches an opaque byte payload to the cmd, stored as a buffer
+// property, so binary protocol bridges do not have to base64 the payload into
+// a string property. The bytes are copied.
+func (p *cmd) SetBuf(buf []byte) error {
+	return p.SetPropertyBytes(CmdBufPropertyName, buf)
+}
+
+// GetBuf returns a copy of the byte payload attached with SetBuf.
+func (p *cmd) GetBuf() ([]byte, error) {
+	return p.GetPropertyBytes(CmdBufPropertyName)
+}
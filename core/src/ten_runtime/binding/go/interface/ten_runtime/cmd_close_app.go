@@ -0,0 +1,50 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+// CmdNameCloseApp is the well-known name of the command which asks the app to
+// perform an orderly shutdown. Extensions used to send this name as a magic
+// string; use this constant (or the CloseApp helper on TenEnv) instead.
+const CmdNameCloseApp = "ten:close_app"
+
+// NewCloseAppCmd creates a close app command whose destination is already set
+// to the app itself, so it can be sent directly with tenEnv.SendCmd().
+func NewCloseAppCmd() (Cmd, error) {
+	closeAppCmd, err := NewCmd(CmdNameCloseApp)
+	if err != nil {
+		return nil, err
+	}
+
+	// An empty location means the app the sending extension belongs to.
+	err = closeAppCmd.SetDests(Loc{
+		AppURI:        Ptr(""),
+		GraphID:       Ptr(""),
+		ExtensionName: Ptr(""),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return closeAppCmd, nil
+}
+
+// CloseApp requests an orderly shutdown of the app the extension belongs to.
+//
+// The app closes all of its running engines (graphs) first, which triggers
+// OnStop/OnDeinit on every extension in those graphs; after all engines have
+// closed, the app itself closes and App.Wait() returns. The request is
+// asynchronous: CloseApp returning nil only means the close app command has
+// been submitted to the runtime.
+func (p *tenEnv) CloseApp() error {
+	closeAppCmd, err := NewCloseAppCmd()
+	if err != nil {
+		return err
+	}
+
+	return p.SendCmd(closeAppCmd, nil)
+}
@@ -10,6 +10,8 @@ package ten_runtime
 // #include "cmd.h"
 import "C"
 
+import "fmt"
+
 // StatusCode is an alias of TEN_STATUS_CODE from TEN runtime.
 type StatusCode int8
 
@@ -34,6 +36,24 @@ func (s StatusCode) valid() bool {
 	return s > statusCodeInvalid && s < statusCodeLast
 }
 
+// IsOk reports whether the status code denotes success.
+func (s StatusCode) IsOk() bool {
+	return s == StatusCodeOk
+}
+
+// String renders the status code for logs and test failures, instead of a
+// raw integer.
+func (s StatusCode) String() string {
+	switch s {
+	case StatusCodeOk:
+		return "ok"
+	case StatusCodeError:
+		return "error"
+	default:
+		return fmt.Sprintf("invalid(%d)", int8(s))
+	}
+}
+
 // CmdResult is the interface for the cmd result.
 type CmdResult interface {
 	CmdBase
@@ -43,10 +63,32 @@ type CmdResult interface {
 	SetFinal(isFinal bool) error
 	IsFinal() (bool, error)
 	IsCompleted() (bool, error)
+
+	// GetSequenceNumber returns the send order (1-based) of this result
+	// within its command, stamped by ReturnResult. See
+	// ResultSequencePropertyName.
+	GetSequenceNumber() (uint64, error)
+
+	// AppendError, PropagateErrorChain and GetErrorChain maintain a
+	// structured cause chain across hops, so the extension that started a
+	// multi-hop command can inspect the original failure instead of only
+	// the nearest "detail" string. See ErrorChainPropertyName.
+	AppendError(source string, err error) error
+	PropagateErrorChain(downstream CmdResult) error
+	GetErrorChain() ([]ErrorInfo, error)
+
+	// Timings returns the timeline recorded for the command this result
+	// responds to, when EnableCmdTimings is on. See CmdTimings.
+	Timings() (CmdTimings, error)
 }
 
 type cmdResult struct {
 	*cmd
+
+	// targetCmd is the Go-side wrapper of the command this result responds
+	// to; nil for results received from the runtime. It carries the
+	// double-return guard used by ReturnResult.
+	targetCmd *cmd
 }
 
 // NewCmdResult creates a new cmd result.
@@ -65,6 +107,9 @@ func NewCmdResult(statusCode StatusCode, targetCmd Cmd) (CmdResult, error) {
 	case error:
 		return nil, res
 	case *cmdResult:
+		if tc, ok := targetCmd.(*cmd); ok {
+			res.targetCmd = tc
+		}
 		return res, nil
 	default:
 		panic("Should not happen.")
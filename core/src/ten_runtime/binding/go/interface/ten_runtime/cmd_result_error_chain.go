@@ -0,0 +1,139 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// ErrorChainPropertyName is the reserved property on a cmd result that
+// carries the structured cause chain across hops. Like the other `ten:`
+// names it is metadata, not user data.
+const ErrorChainPropertyName = "ten:error_chain"
+
+// ErrorInfo is one hop of a cmd result's cause chain: which extension
+// failed (or relayed the failure) and with what error. The chain is ordered
+// from the original failure outward.
+type ErrorInfo struct {
+	Source  string       `json:"source"`
+	Code    TenErrorCode `json:"code"`
+	Message string       `json:"message"`
+}
+
+// decodeErrorChain parses the chain property's JSON.
+func decodeErrorChain(jsonBytes []byte) ([]ErrorInfo, error) {
+	var chain []ErrorInfo
+	if err := json.Unmarshal(jsonBytes, &chain); err != nil {
+		return nil, NewTenError(ErrorCodeInvalidJSON, err.Error())
+	}
+
+	return chain, nil
+}
+
+// appendErrorChain appends one hop to an encoded chain; existing may be nil
+// for the first hop.
+func appendErrorChain(existing []byte, info ErrorInfo) ([]byte, error) {
+	var chain []ErrorInfo
+	if len(existing) > 0 {
+		var err error
+		chain, err = decodeErrorChain(existing)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	chain = append(chain, info)
+
+	jsonBytes, err := json.Marshal(chain)
+	if err != nil {
+		return nil, NewTenError(ErrorCodeInvalidJSON, err.Error())
+	}
+
+	return jsonBytes, nil
+}
+
+// errorInfoFrom flattens an error into one chain hop, keeping the code when
+// it is a TenError.
+func errorInfoFrom(source string, err error) ErrorInfo {
+	info := ErrorInfo{Source: source, Code: ErrorCodeGeneric}
+	if err != nil {
+		info.Message = err.Error()
+	}
+
+	var tenErr *TenError
+	if errors.As(err, &tenErr) {
+		info.Code = tenErr.ErrorCode
+		info.Message = tenErr.ErrorMessage
+	}
+
+	return info
+}
+
+// AppendError adds one hop to this result's cause chain: the name of the
+// extension recording the failure and the error it saw. An extension that
+// relays a downstream failure should first copy the downstream chain with
+// PropagateErrorChain, then append its own hop, so the receiver can walk
+// from the original failure outward.
+func (p *cmdResult) AppendError(source string, err error) error {
+	defer p.keepAlive()
+
+	existing, _ := p.GetPropertyToJSONBytes(ErrorChainPropertyName)
+	if existing != nil {
+		defer ReleaseBytes(existing)
+	}
+
+	jsonBytes, encodeErr := appendErrorChain(
+		existing,
+		errorInfoFrom(source, err),
+	)
+	if encodeErr != nil {
+		return encodeErr
+	}
+
+	return p.SetPropertyFromJSONBytes(ErrorChainPropertyName, jsonBytes)
+}
+
+// PropagateErrorChain copies the cause chain from a downstream result onto
+// this one, so the failure's origin survives the hop. A downstream result
+// without a chain is a no-op.
+func (p *cmdResult) PropagateErrorChain(downstream CmdResult) error {
+	if downstream == nil {
+		return NewTenError(
+			ErrorCodeInvalidArgument,
+			"downstream is required.",
+		)
+	}
+
+	defer p.keepAlive()
+
+	jsonBytes, err := downstream.GetPropertyToJSONBytes(
+		ErrorChainPropertyName,
+	)
+	if err != nil {
+		// No chain to carry forward.
+		return nil
+	}
+	defer ReleaseBytes(jsonBytes)
+
+	return p.SetPropertyFromJSONBytes(ErrorChainPropertyName, jsonBytes)
+}
+
+// GetErrorChain returns this result's cause chain, ordered from the
+// original failure outward, or nil when no extension recorded one.
+func (p *cmdResult) GetErrorChain() ([]ErrorInfo, error) {
+	defer p.keepAlive()
+
+	jsonBytes, err := p.GetPropertyToJSONBytes(ErrorChainPropertyName)
+	if err != nil {
+		return nil, nil
+	}
+	defer ReleaseBytes(jsonBytes)
+
+	return decodeErrorChain(jsonBytes)
+}
@@ -0,0 +1,71 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestAppendErrorChainBuildsOrderedChain(t *testing.T) {
+	first, err := appendErrorChain(nil, ErrorInfo{
+		Source:  "extension_c",
+		Code:    ErrorCodeTimeout,
+		Message: "deadline exceeded",
+	})
+	if err != nil {
+		t.FailNow()
+	}
+
+	second, err := appendErrorChain(first, ErrorInfo{
+		Source:  "extension_b",
+		Code:    ErrorCodeGeneric,
+		Message: "downstream failed",
+	})
+	if err != nil {
+		t.FailNow()
+	}
+
+	chain, err := decodeErrorChain(second)
+	if err != nil || len(chain) != 2 {
+		t.FailNow()
+	}
+	if chain[0].Source != "extension_c" ||
+		chain[0].Code != ErrorCodeTimeout {
+		t.FailNow()
+	}
+	if chain[1].Source != "extension_b" {
+		t.FailNow()
+	}
+}
+
+func TestErrorInfoFromKeepsTenErrorCode(t *testing.T) {
+	info := errorInfoFrom(
+		"extension_b",
+		NewTenError(ErrorCodeInvalidArgument, "bad input."),
+	)
+
+	if info.Code != ErrorCodeInvalidArgument ||
+		info.Message != "bad input." {
+		t.FailNow()
+	}
+}
+
+func TestErrorInfoFromPlainError(t *testing.T) {
+	info := errorInfoFrom("extension_b", errors.New("boom"))
+
+	if info.Code != ErrorCodeGeneric || info.Message != "boom" {
+		t.FailNow()
+	}
+}
+
+func TestDecodeErrorChainRejectsBadJSON(t *testing.T) {
+	if _, err := decodeErrorChain([]byte("not json")); err == nil {
+		t.FailNow()
+	}
+}
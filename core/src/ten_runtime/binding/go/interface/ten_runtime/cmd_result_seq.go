@@ -0,0 +1,27 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+// The runtime itself does not document an ordering guarantee for streamed
+// (non-final) results of a single command, so the Go binding provides one at
+// the API level instead: ReturnResult stamps every result with a sequence
+// number reflecting send order, and callers that observe results out of order
+// can reorder them via GetSequenceNumber.
+
+// ResultSequencePropertyName is the property ReturnResult stamps on every
+// result with the 1-based send order within its command, so a receiver of
+// streamed results can detect and repair reordering.
+const ResultSequencePropertyName = "ten:result_seq"
+
+// GetSequenceNumber returns the 1-based position of this result in the send
+// order of its command, as stamped by ReturnResult. Results produced outside
+// the Go binding may not carry a sequence number, in which case an error is
+// returned.
+func (p *cmdResult) GetSequenceNumber() (uint64, error) {
+	return p.GetPropertyUint64(ResultSequencePropertyName)
+}
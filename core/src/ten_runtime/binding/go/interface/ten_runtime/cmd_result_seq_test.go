@@ -0,0 +1,53 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestNextResultSeqIsMonotonic(t *testing.T) {
+	target := &cmd{}
+
+	for want := uint64(1); want <= 10; want++ {
+		if got := target.nextResultSeq(); got != want {
+			t.FailNow()
+		}
+	}
+}
+
+func TestNextResultSeqIsUniqueUnderConcurrency(t *testing.T) {
+	const goroutines = 8
+	const perGoroutine = 100
+
+	target := &cmd{}
+
+	var mu sync.Mutex
+	seen := make(map[uint64]struct{}, goroutines*perGoroutine)
+
+	var wg sync.WaitGroup
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < perGoroutine; j++ {
+				seq := target.nextResultSeq()
+
+				mu.Lock()
+				seen[seq] = struct{}{}
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if len(seen) != goroutines*perGoroutine {
+		t.FailNow()
+	}
+}
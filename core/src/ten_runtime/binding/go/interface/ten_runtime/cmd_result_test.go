@@ -0,0 +1,29 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import "testing"
+
+func TestStatusCodeString(t *testing.T) {
+	if StatusCode(StatusCodeOk).String() != "ok" {
+		t.FailNow()
+	}
+
+	if StatusCode(StatusCodeError).String() != "error" {
+		t.FailNow()
+	}
+
+	if statusCodeInvalid.String() != "invalid(-1)" {
+		t.FailNow()
+	}
+
+	if !StatusCode(StatusCodeOk).IsOk() ||
+		StatusCode(StatusCodeError).IsOk() {
+		t.FailNow()
+	}
+}
@@ -0,0 +1,81 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+// CmdHandler handles one named cmd dispatched by a CmdRouter.
+type CmdHandler func(tenEnv TenEnv, cmd Cmd)
+
+// CmdRouter dispatches incoming cmds to handlers registered by name, removing
+// the GetName-and-switch boilerplate from OnCmd. Embed it in an extension in
+// place of DefaultExtension and register handlers before the extension
+// receives cmds (typically in OnConfigure or OnInit):
+//
+//	type myExtension struct {
+//	    ten.CmdRouter
+//	}
+//
+//	func (p *myExtension) OnInit(tenEnv ten.TenEnv) {
+//	    p.Handle("greeting", p.onGreeting)
+//	    tenEnv.OnInitDone()
+//	}
+//
+// A cmd whose name has no registered handler goes to the default handler; if
+// none is set, the router returns an error result so the sender is not left
+// waiting.
+//
+// Registration is not safe for concurrent use with dispatch.
+type CmdRouter struct {
+	DefaultExtension
+
+	handlers       map[string]CmdHandler
+	defaultHandler CmdHandler
+}
+
+// Handle registers a handler for cmds with the given name, replacing any
+// previous handler for that name. It returns the router to allow chaining.
+func (p *CmdRouter) Handle(name string, handler CmdHandler) *CmdRouter {
+	if p.handlers == nil {
+		p.handlers = make(map[string]CmdHandler)
+	}
+
+	p.handlers[name] = handler
+	return p
+}
+
+// HandleDefault registers the handler for cmds whose name has no dedicated
+// handler. It returns the router to allow chaining.
+func (p *CmdRouter) HandleDefault(handler CmdHandler) *CmdRouter {
+	p.defaultHandler = handler
+	return p
+}
+
+// OnCmd dispatches the cmd by name.
+func (p *CmdRouter) OnCmd(tenEnv TenEnv, cmd Cmd) {
+	name, err := cmd.GetName()
+	if err == nil {
+		if handler, ok := p.handlers[name]; ok {
+			handler(tenEnv, cmd)
+			return
+		}
+	}
+
+	if p.defaultHandler != nil {
+		p.defaultHandler(tenEnv, cmd)
+		return
+	}
+
+	tenEnv.LogWarn("No handler registered for cmd: " + name)
+
+	result, err := NewCmdResult(StatusCodeError, cmd)
+	if err != nil {
+		return
+	}
+
+	result.SetPropertyString("detail", "unknown cmd: "+name)
+	tenEnv.ReturnResult(result, nil)
+}
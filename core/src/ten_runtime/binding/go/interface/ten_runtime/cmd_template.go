@@ -0,0 +1,62 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+// CmdTemplate is a pre-encoded command prototype for hot paths that send
+// the same fixed-shape message many times. The fixed properties are built
+// and encoded into the runtime's value tree once, at template creation;
+// each Instantiate clones the prototype inside the runtime and only the
+// variable fields are encoded per send, skipping the Go-side encoding of
+// the fixed part.
+//
+// Immutability: the prototype is owned by the template and must not be
+// mutated after creation — every instance is cloned from it, so a mutation
+// would leak into all later instances. Mutate only the Cmd returned by
+// Instantiate; each instance is independent of the template and of other
+// instances.
+//
+// A template is safe for concurrent Instantiate calls as long as nothing
+// mutates the prototype, which is exactly the constraint above.
+type CmdTemplate struct {
+	prototype Cmd
+}
+
+// NewCmdTemplate builds a template for a command with the given name and
+// fixed properties. The fixed properties follow the same value rules as
+// NewCmdFromMap.
+func NewCmdTemplate(
+	name string,
+	fixedProps map[string]any,
+) (*CmdTemplate, error) {
+	prototype, err := NewCmdFromMap(name, fixedProps)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CmdTemplate{prototype: prototype}, nil
+}
+
+// Instantiate clones the prototype and applies the variable fields, which
+// may be nil for an instance with only the fixed shape. The returned Cmd is
+// ready to send and independent of the template.
+func (p *CmdTemplate) Instantiate(variable map[string]any) (Cmd, error) {
+	instance, err := p.prototype.Clone()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(variable) == 0 {
+		return instance, nil
+	}
+
+	if err := applyPropMap(instance, variable); err != nil {
+		return nil, err
+	}
+
+	return instance, nil
+}
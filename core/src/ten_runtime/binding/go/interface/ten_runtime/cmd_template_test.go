@@ -0,0 +1,16 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import "testing"
+
+func TestNewCmdTemplateRequiresName(t *testing.T) {
+	if _, err := NewCmdTemplate("", nil); err == nil {
+		t.FailNow()
+	}
+}
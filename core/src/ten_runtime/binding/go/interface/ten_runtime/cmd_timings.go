@@ -0,0 +1,150 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"encoding/json"
+	"sync/atomic"
+	"time"
+)
+
+// TimingsPropertyName is the reserved property under which the binding
+// stamps per-command timestamps when timing collection is enabled. Like the
+// other `ten:` names it is metadata, not user data.
+const TimingsPropertyName = "ten:timings"
+
+// Timestamp keys stamped along a command's path; values are microseconds
+// since the Unix epoch.
+const (
+	timingEnqueue      = "enqueue"
+	timingDequeue      = "dequeue"
+	timingHandlerStart = "handler_start"
+	timingHandlerEnd   = "handler_end"
+	timingReturned     = "returned"
+)
+
+// cmdTimingsEnabled gates the stamping, off by default so the extra property
+// writes cost nothing unless someone is measuring.
+var cmdTimingsEnabled atomic.Bool
+
+// EnableCmdTimings toggles per-command timing collection process-wide. When
+// enabled, SendCmd stamps the enqueue time, the dispatch path stamps dequeue
+// and the handler window, and ReturnResult stamps the return time; the
+// receiver reads them back with CmdResult.Timings. Commands sent while
+// disabled carry no timings.
+func EnableCmdTimings(enabled bool) {
+	cmdTimingsEnabled.Store(enabled)
+}
+
+// stampCmdTiming records one timestamp on the message when collection is
+// enabled. Failures are swallowed: measurement must never fail the command.
+func stampCmdTiming(target interface {
+	SetProperty(path string, value any) error
+}, key string) {
+	if !cmdTimingsEnabled.Load() {
+		return
+	}
+
+	target.SetProperty(
+		TimingsPropertyName+"."+key,
+		time.Now().UnixMicro(),
+	)
+}
+
+// CmdTimings is the per-command timeline recorded along the send, dispatch
+// and return path, separating queueing delay from handler time. Zero fields
+// mean the corresponding point was not recorded (ex: collection was enabled
+// mid-flight).
+type CmdTimings struct {
+	EnqueuedAt     time.Time
+	DequeuedAt     time.Time
+	HandlerStartAt time.Time
+	HandlerEndAt   time.Time
+	ReturnedAt     time.Time
+
+	// QueueDelay is dequeue minus enqueue; HandlerDuration is the
+	// synchronous handler window; TotalDuration is return minus enqueue.
+	// Each is zero when either endpoint is missing.
+	QueueDelay      time.Duration
+	HandlerDuration time.Duration
+	TotalDuration   time.Duration
+}
+
+// computeCmdTimings derives the timeline from the raw microsecond stamps.
+func computeCmdTimings(stamps map[string]int64) CmdTimings {
+	at := func(key string) time.Time {
+		micros, ok := stamps[key]
+		if !ok {
+			return time.Time{}
+		}
+		return time.UnixMicro(micros)
+	}
+
+	timings := CmdTimings{
+		EnqueuedAt:     at(timingEnqueue),
+		DequeuedAt:     at(timingDequeue),
+		HandlerStartAt: at(timingHandlerStart),
+		HandlerEndAt:   at(timingHandlerEnd),
+		ReturnedAt:     at(timingReturned),
+	}
+
+	span := func(from, to time.Time) time.Duration {
+		if from.IsZero() || to.IsZero() {
+			return 0
+		}
+		return to.Sub(from)
+	}
+
+	timings.QueueDelay = span(timings.EnqueuedAt, timings.DequeuedAt)
+	timings.HandlerDuration = span(
+		timings.HandlerStartAt,
+		timings.HandlerEndAt,
+	)
+	timings.TotalDuration = span(timings.EnqueuedAt, timings.ReturnedAt)
+
+	return timings
+}
+
+// Timings returns the timeline recorded for the command this result responds
+// to. A result without timings (collection disabled, or a runtime-generated
+// result) yields the zero CmdTimings.
+func (p *cmdResult) Timings() (CmdTimings, error) {
+	defer p.keepAlive()
+
+	jsonBytes, err := p.GetPropertyToJSONBytes(TimingsPropertyName)
+	if err != nil {
+		return CmdTimings{}, nil
+	}
+	defer ReleaseBytes(jsonBytes)
+
+	var stamps map[string]int64
+	if err := json.Unmarshal(jsonBytes, &stamps); err != nil {
+		return CmdTimings{}, NewTenError(
+			ErrorCodeInvalidJSON,
+			err.Error(),
+		)
+	}
+
+	return computeCmdTimings(stamps), nil
+}
+
+// propagateCmdTimings copies the stamps from the command onto its result in
+// ReturnResult, so the sender sees the full timeline.
+func propagateCmdTimings(target *cmd, result CmdResult) {
+	if !cmdTimingsEnabled.Load() {
+		return
+	}
+
+	jsonBytes, err := target.GetPropertyToJSONBytes(TimingsPropertyName)
+	if err != nil {
+		return
+	}
+	defer ReleaseBytes(jsonBytes)
+
+	result.SetPropertyFromJSONBytes(TimingsPropertyName, jsonBytes)
+}
@@ -0,0 +1,79 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestComputeCmdTimingsFullTimeline(t *testing.T) {
+	base := time.Now().UnixMicro()
+	timings := computeCmdTimings(map[string]int64{
+		timingEnqueue:      base,
+		timingDequeue:      base + 1000,
+		timingHandlerStart: base + 1100,
+		timingHandlerEnd:   base + 4100,
+		timingReturned:     base + 5000,
+	})
+
+	if timings.QueueDelay != time.Millisecond {
+		t.FailNow()
+	}
+	if timings.HandlerDuration != 3*time.Millisecond {
+		t.FailNow()
+	}
+	if timings.TotalDuration != 5*time.Millisecond {
+		t.FailNow()
+	}
+}
+
+func TestComputeCmdTimingsPartialStamps(t *testing.T) {
+	base := time.Now().UnixMicro()
+	timings := computeCmdTimings(map[string]int64{
+		timingEnqueue: base,
+	})
+
+	if timings.EnqueuedAt.IsZero() {
+		t.FailNow()
+	}
+	if !timings.DequeuedAt.IsZero() {
+		t.FailNow()
+	}
+	if timings.QueueDelay != 0 || timings.TotalDuration != 0 {
+		t.FailNow()
+	}
+}
+
+func TestStampCmdTimingDisabledIsNoop(t *testing.T) {
+	EnableCmdTimings(false)
+
+	env := newAppTesterTenEnv()
+	stampCmdTiming(env, timingEnqueue)
+
+	if _, err := env.GetPropertyInt64(
+		TimingsPropertyName + "." + timingEnqueue,
+	); err == nil {
+		t.FailNow()
+	}
+}
+
+func TestStampCmdTimingEnabled(t *testing.T) {
+	EnableCmdTimings(true)
+	defer EnableCmdTimings(false)
+
+	env := newAppTesterTenEnv()
+	stampCmdTiming(env, timingEnqueue)
+
+	micros, err := env.GetPropertyInt64(
+		TimingsPropertyName + "." + timingEnqueue,
+	)
+	if err != nil || micros <= 0 {
+		t.FailNow()
+	}
+}
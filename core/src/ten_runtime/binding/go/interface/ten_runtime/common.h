@@ -178,3 +178,17 @@ typedef struct ten_go_error_t {
 ten_go_error_t ten_go_copy_c_str_to_slice_and_free(const char *src, void *dest);
 
 void ten_go_free_c_str(const char *str);
+
+/**
+ * @brief Flush any log records buffered by the TEN runtime to their final
+ * destination (the configured log file, or the standard streams). Calling this
+ * function more than once is harmless.
+ */
+void ten_go_log_flush(void);
+
+/**
+ * @brief Get the current time (in microseconds) on the runtime's clock, i.e.
+ * the same clock that stamps message timestamps, so latency can be measured
+ * without mixing clocks across the language boundary.
+ */
+int64_t ten_go_now_us(void);
@@ -24,6 +24,10 @@ type Data interface {
 	LockBuf() ([]byte, error)
 	UnlockBuf(buf *[]byte) error
 	GetBuf() ([]byte, error)
+
+	// DecodeAs decodes the buffer into v with a codec registered through
+	// RegisterDataCodec; the counterpart of NewDataEncoded.
+	DecodeAs(codecName string, v any) error
 }
 
 type data struct {
@@ -71,6 +75,36 @@ func NewData(dataName string) (Data, error) {
 	return newData(bridge), nil
 }
 
+// NewDataWithBuf creates a new data message carrying a copy of the given
+// buffer.
+func NewDataWithBuf(dataName string, buf []byte) (Data, error) {
+	d, err := NewData(dataName)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(buf) == 0 {
+		return d, nil
+	}
+
+	if err := d.AllocBuf(len(buf)); err != nil {
+		return nil, err
+	}
+
+	locked, err := d.LockBuf()
+	if err != nil {
+		return nil, err
+	}
+
+	copy(locked, buf)
+
+	if err := d.UnlockBuf(&locked); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
 func (p *data) AllocBuf(size int) error {
 	if size <= 0 {
 		return NewTenError(ErrorCodeInvalidArgument, "the size should be > 0")
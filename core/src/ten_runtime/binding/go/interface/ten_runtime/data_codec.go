@@ -0,0 +1,152 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+// codecMetaKey is the metadata key naming the codec a data message's buffer
+// was encoded with, so receivers can detect a format mismatch. See SetMeta.
+const codecMetaKey = "codec"
+
+// dataCodec is one registered payload format.
+type dataCodec struct {
+	encode func(v any) ([]byte, error)
+	decode func(buf []byte, v any) error
+}
+
+var (
+	dataCodecsMu sync.RWMutex
+	dataCodecs   = map[string]dataCodec{}
+)
+
+// RegisterDataCodec registers a named payload format shared across
+// extensions, so parsing of a data buffer is implemented once instead of in
+// every receiver. Registering an existing name fails; the "json" codec is
+// built in.
+func RegisterDataCodec(
+	name string,
+	encode func(v any) ([]byte, error),
+	decode func(buf []byte, v any) error,
+) error {
+	if len(name) == 0 || encode == nil || decode == nil {
+		return NewTenError(
+			ErrorCodeInvalidArgument,
+			"a codec needs a name, an encode and a decode function.",
+		)
+	}
+
+	dataCodecsMu.Lock()
+	defer dataCodecsMu.Unlock()
+
+	if _, exists := dataCodecs[name]; exists {
+		return NewTenError(
+			ErrorCodeInvalidArgument,
+			fmt.Sprintf("the codec %q is already registered.", name),
+		)
+	}
+
+	dataCodecs[name] = dataCodec{encode: encode, decode: decode}
+	return nil
+}
+
+// lookupDataCodec resolves a codec by name.
+func lookupDataCodec(name string) (dataCodec, error) {
+	dataCodecsMu.RLock()
+	defer dataCodecsMu.RUnlock()
+
+	codec, exists := dataCodecs[name]
+	if !exists {
+		return dataCodec{}, NewTenError(
+			ErrorCodeInvalidArgument,
+			fmt.Sprintf("the codec %q is not registered.", name),
+		)
+	}
+
+	return codec, nil
+}
+
+func init() {
+	RegisterDataCodec(
+		"json",
+		func(v any) ([]byte, error) {
+			return json.Marshal(v)
+		},
+		func(buf []byte, v any) error {
+			return json.Unmarshal(buf, v)
+		},
+	)
+}
+
+// NewDataEncoded creates a data message whose buffer holds v encoded with
+// the named codec. The codec name is recorded in the message metadata, so
+// DecodeAs can reject a format mismatch on the receiving side.
+func NewDataEncoded(dataName string, codecName string, v any) (Data, error) {
+	codec, err := lookupDataCodec(codecName)
+	if err != nil {
+		return nil, err
+	}
+
+	buf, err := codec.encode(v)
+	if err != nil {
+		return nil, NewTenError(
+			ErrorCodeGeneric,
+			fmt.Sprintf("the codec %q failed to encode: %v", codecName, err),
+		)
+	}
+
+	d, err := NewDataWithBuf(dataName, buf)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.SetMeta(codecMetaKey, codecName); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// DecodeAs decodes this data message's buffer into v with the named codec.
+// When the sender recorded a codec name in the metadata, a mismatch with the
+// requested codec fails instead of producing garbage.
+func (p *data) DecodeAs(codecName string, v any) error {
+	codec, err := lookupDataCodec(codecName)
+	if err != nil {
+		return err
+	}
+
+	if recorded, metaErr := p.GetMeta(codecMetaKey); metaErr == nil &&
+		recorded != codecName {
+		return NewTenError(
+			ErrorCodeInvalidType,
+			fmt.Sprintf(
+				"the data was encoded with the codec %q, not %q.",
+				recorded,
+				codecName,
+			),
+		)
+	}
+
+	buf, err := p.GetBuf()
+	if err != nil {
+		return err
+	}
+
+	if err := codec.decode(buf, v); err != nil {
+		return NewTenError(
+			ErrorCodeGeneric,
+			fmt.Sprintf("the codec %q failed to decode: %v", codecName, err),
+		)
+	}
+
+	return nil
+}
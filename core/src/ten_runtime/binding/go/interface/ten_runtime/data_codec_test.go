@@ -0,0 +1,66 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestBuiltinJSONCodecRoundTrip(t *testing.T) {
+	codec, err := lookupDataCodec("json")
+	if err != nil {
+		t.FailNow()
+	}
+
+	buf, err := codec.encode(map[string]any{"k": "v"})
+	if err != nil {
+		t.FailNow()
+	}
+
+	var decoded map[string]any
+	if err := codec.decode(buf, &decoded); err != nil {
+		t.FailNow()
+	}
+	if decoded["k"] != "v" {
+		t.FailNow()
+	}
+}
+
+func TestRegisterDataCodecRejectsDuplicate(t *testing.T) {
+	encode := func(v any) ([]byte, error) { return json.Marshal(v) }
+	decode := func(buf []byte, v any) error { return json.Unmarshal(buf, v) }
+
+	if err := RegisterDataCodec(
+		"test_codec_dup",
+		encode,
+		decode,
+	); err != nil {
+		t.FailNow()
+	}
+
+	if err := RegisterDataCodec(
+		"test_codec_dup",
+		encode,
+		decode,
+	); err == nil {
+		t.FailNow()
+	}
+}
+
+func TestRegisterDataCodecValidatesArguments(t *testing.T) {
+	if err := RegisterDataCodec("", nil, nil); err == nil {
+		t.FailNow()
+	}
+}
+
+func TestLookupDataCodecUnknown(t *testing.T) {
+	if _, err := lookupDataCodec("no_such_codec"); err == nil {
+		t.FailNow()
+	}
+}
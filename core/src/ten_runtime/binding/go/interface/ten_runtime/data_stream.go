@@ -0,0 +1,191 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"sync"
+)
+
+// Well-known property names carried by every data message of a data stream,
+// so the receiving side can restore ordering and detect the end of stream.
+const (
+	// DataStreamSeqPropertyName holds the zero-based sequence number of a
+	// chunk within its stream.
+	DataStreamSeqPropertyName = "ten:data_stream_seq"
+
+	// DataStreamEOFPropertyName is set to true on the final (empty) data
+	// message which closes the stream.
+	DataStreamEOFPropertyName = "ten:data_stream_eof"
+)
+
+// dataStreamMaxInFlight bounds the number of unacknowledged chunks, providing
+// simple flow control so a fast producer can not flood the message queue.
+const dataStreamMaxInFlight = 16
+
+// DataStream is a logical stream of data messages to a destination, modeled
+// on io.WriteCloser. Each Write sends one data message carrying the chunk and
+// a sequence number; Close sends a final end-of-stream marker. Writes block
+// when too many chunks are still unacknowledged by the runtime.
+type DataStream interface {
+	// Write sends one chunk on the stream. It implements io.Writer.
+	Write(chunk []byte) (int, error)
+
+	// Close sends the end-of-stream marker. The stream can not be used after
+	// Close. It implements io.Closer.
+	Close() error
+}
+
+type dataStream struct {
+	tenEnv TenEnv
+	name   string
+	dests  []Loc
+
+	// slots implements the in-flight window; a slot is taken per chunk sent
+	// and given back when the runtime acknowledges the chunk.
+	slots chan struct{}
+
+	mu     sync.Mutex
+	seq    int64
+	closed bool
+	err    error // The first async delivery error; sticky.
+}
+
+var _ DataStream = new(dataStream)
+
+// NewDataStream opens a logical data stream on the given ten env. The name is
+// used as the name of every data message of the stream. The dests are
+// optional; without them the graph routing of the data name applies.
+func NewDataStream(
+	tenEnv TenEnv,
+	name string,
+	dests ...Loc,
+) (DataStream, error) {
+	if tenEnv == nil {
+		return nil, NewTenError(
+			ErrorCodeInvalidArgument,
+			"tenEnv is required.",
+		)
+	}
+
+	if len(name) == 0 {
+		return nil, NewTenError(
+			ErrorCodeInvalidArgument,
+			"data name is required.",
+		)
+	}
+
+	return &dataStream{
+		tenEnv: tenEnv,
+		name:   name,
+		dests:  dests,
+		slots:  make(chan struct{}, dataStreamMaxInFlight),
+	}, nil
+}
+
+// sendChunk builds and sends one data message of the stream, blocking while
+// the in-flight window is full.
+func (p *dataStream) sendChunk(chunk []byte, eof bool) error {
+	data, err := NewData(p.name)
+	if err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	seq := p.seq
+	p.seq++
+	p.mu.Unlock()
+
+	if err := data.SetProperty(DataStreamSeqPropertyName, seq); err != nil {
+		return err
+	}
+
+	if eof {
+		if err := data.SetProperty(DataStreamEOFPropertyName, true); err != nil {
+			return err
+		}
+	}
+
+	if len(chunk) > 0 {
+		if err := data.AllocBuf(len(chunk)); err != nil {
+			return err
+		}
+
+		buf, err := data.LockBuf()
+		if err != nil {
+			return err
+		}
+
+		copy(buf, chunk)
+
+		if err := data.UnlockBuf(&buf); err != nil {
+			return err
+		}
+	}
+
+	if len(p.dests) > 0 {
+		if err := data.SetDests(p.dests...); err != nil {
+			return err
+		}
+	}
+
+	// Take an in-flight slot; blocks while the window is full.
+	p.slots <- struct{}{}
+
+	err = p.tenEnv.SendData(data, func(te TenEnv, sendErr error) {
+		if sendErr != nil {
+			p.mu.Lock()
+			if p.err == nil {
+				p.err = sendErr
+			}
+			p.mu.Unlock()
+		}
+
+		<-p.slots
+	})
+	if err != nil {
+		<-p.slots
+		return err
+	}
+
+	return nil
+}
+
+func (p *dataStream) Write(chunk []byte) (int, error) {
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		return 0, NewTenError(
+			ErrorCodeGeneric,
+			"the data stream is closed",
+		)
+	}
+	err := p.err
+	p.mu.Unlock()
+
+	if err != nil {
+		return 0, err
+	}
+
+	if err := p.sendChunk(chunk, false); err != nil {
+		return 0, err
+	}
+
+	return len(chunk), nil
+}
+
+func (p *dataStream) Close() error {
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		return nil
+	}
+	p.closed = true
+	p.mu.Unlock()
+
+	return p.sendChunk(nil, true)
+}
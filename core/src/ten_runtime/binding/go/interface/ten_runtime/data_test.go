@@ -0,0 +1,36 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestNewDataWithBufRoundTrip(t *testing.T) {
+	payload := []byte{0x01, 0x02, 0x03, 0x04}
+
+	d, err := NewDataWithBuf("ping", payload)
+	if err != nil {
+		t.FailNow()
+	}
+
+	got, err := d.GetBuf()
+	if err != nil {
+		t.FailNow()
+	}
+
+	if !bytes.Equal(got, payload) {
+		t.FailNow()
+	}
+
+	name, err := d.GetName()
+	if err != nil || name != "ping" {
+		t.FailNow()
+	}
+}
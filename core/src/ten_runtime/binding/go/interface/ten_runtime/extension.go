@@ -161,7 +161,13 @@ func tenGoExtensionOnConfigure(
 
 	tenEnvInstance.attachToExtension()
 
-	extensionObj.OnConfigure(tenEnvObj)
+	// The extension is alive from now until OnDeinitDone; the app's OnDeinit
+	// waits for this count to drain.
+	liveExtensions.begin()
+
+	guardExtensionCallback(tenEnvObj, func() {
+		extensionObj.OnConfigure(tenEnvObj)
+	})
 }
 
 //export tenGoExtensionOnInit
@@ -203,7 +209,11 @@ func tenGoExtensionOnInit(
 	//
 	//   extensionObj.Extension.OnInit(tenEnvObj, propertyObj)
 	//
-	extensionObj.OnInit(tenEnvObj)
+	tenEnvInstance.armLifecycleWatchdog("OnInit")
+
+	guardExtensionCallback(tenEnvObj, func() {
+		extensionObj.OnInit(tenEnvObj)
+	})
 }
 
 //export tenGoExtensionOnStart
@@ -228,7 +238,13 @@ func tenGoExtensionOnStart(extensionID C.uintptr_t, tenEnvID C.uintptr_t) {
 		)
 	}
 
-	extensionObj.OnStart(tenEnvObj)
+	if te, ok := tenEnvObj.(*tenEnv); ok {
+		te.armLifecycleWatchdog("OnStart")
+	}
+
+	guardExtensionCallback(tenEnvObj, func() {
+		extensionObj.OnStart(tenEnvObj)
+	})
 }
 
 //export tenGoExtensionOnStop
@@ -253,7 +269,13 @@ func tenGoExtensionOnStop(extensionID C.uintptr_t, tenEnvID C.uintptr_t) {
 		)
 	}
 
-	extensionObj.OnStop(tenEnvObj)
+	if te, ok := tenEnvObj.(*tenEnv); ok {
+		te.armLifecycleWatchdog("OnStop")
+	}
+
+	guardExtensionCallback(tenEnvObj, func() {
+		extensionObj.OnStop(tenEnvObj)
+	})
 }
 
 //export tenGoExtensionOnDeinit
@@ -278,7 +300,9 @@ func tenGoExtensionOnDeinit(extensionID C.uintptr_t, tenEnvID C.uintptr_t) {
 		)
 	}
 
-	extensionObj.OnDeinit(tenEnvObj)
+	guardExtensionCallback(tenEnvObj, func() {
+		extensionObj.OnDeinit(tenEnvObj)
+	})
 }
 
 //export tenGoExtensionOnCmd
@@ -308,8 +332,19 @@ func tenGoExtensionOnCmd(
 	}
 
 	// The GO cmd object should be created in GO side, and managed by the GO GC.
-	customCmd := newCmd(cmdBridge)
-	extensionObj.OnCmd(tenEnvObj, customCmd)
+	newCmdObj := newCmd(cmdBridge)
+	stampCmdTiming(newCmdObj, timingDequeue)
+
+	customCmd, deliver := inboundCmd(tenEnvObj, newCmdObj)
+	if !deliver {
+		return
+	}
+
+	stampCmdTiming(customCmd, timingHandlerStart)
+	guardExtensionCallback(tenEnvObj, func() {
+		extensionObj.OnCmd(tenEnvObj, customCmd)
+	})
+	stampCmdTiming(customCmd, timingHandlerEnd)
 }
 
 //export tenGoExtensionOnData
@@ -340,8 +375,14 @@ func tenGoExtensionOnData(
 
 	// The GO data object should be created in GO side, and managed by the GO
 	// GC.
-	d := newData(dataBridge)
-	extensionObj.OnData(tenEnvObj, d)
+	d, deliver := inboundData(tenEnvObj, newData(dataBridge))
+	if !deliver {
+		return
+	}
+
+	guardExtensionCallback(tenEnvObj, func() {
+		extensionObj.OnData(tenEnvObj, d)
+	})
 }
 
 //export tenGoExtensionOnVideoFrame
@@ -370,8 +411,17 @@ func tenGoExtensionOnVideoFrame(
 		)
 	}
 
-	videoFrameObj := newVideoFrame(videoFrameBridge)
-	extensionObj.OnVideoFrame(tenEnvObj, videoFrameObj)
+	videoFrameObj, deliver := inboundVideoFrame(
+		tenEnvObj,
+		newVideoFrame(videoFrameBridge),
+	)
+	if !deliver {
+		return
+	}
+
+	guardExtensionCallback(tenEnvObj, func() {
+		extensionObj.OnVideoFrame(tenEnvObj, videoFrameObj)
+	})
 }
 
 //export tenGoExtensionOnAudioFrame
@@ -400,6 +450,15 @@ func tenGoExtensionOnAudioFrame(
 		)
 	}
 
-	audioFrameObj := newAudioFrame(audioFrameBridge)
-	extensionObj.OnAudioFrame(tenEnvObj, audioFrameObj)
+	audioFrameObj, deliver := inboundAudioFrame(
+		tenEnvObj,
+		newAudioFrame(audioFrameBridge),
+	)
+	if !deliver {
+		return
+	}
+
+	guardExtensionCallback(tenEnvObj, func() {
+		extensionObj.OnAudioFrame(tenEnvObj, audioFrameObj)
+	})
 }
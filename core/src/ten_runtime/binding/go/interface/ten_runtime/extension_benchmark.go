@@ -0,0 +1,247 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// BenchmarkReport summarizes one benchmark run of an extension's OnCmd path.
+// Latency percentiles are computed over the individual command round trips;
+// QPS is the completed command count divided by the wall time of the run.
+type BenchmarkReport struct {
+	Requests int
+	Elapsed  time.Duration
+	QPS      float64
+
+	P50 time.Duration
+	P95 time.Duration
+	P99 time.Duration
+}
+
+// ExtensionBenchmark drives an addon's OnCmd path under load and reports
+// throughput and latency, reusing the tester plumbing so the extension runs
+// exactly as it does under ExtensionTester. A benchmark is reusable: each
+// Drive call creates a fresh single-use tester underneath. The tentest
+// subpackage adapts a benchmark to Go's testing.B runner.
+type ExtensionBenchmark struct {
+	addonName    string
+	propertyJSON string
+	cmdName      string
+	timeout      time.Duration
+}
+
+// NewExtensionBenchmark creates a benchmark harness for the given addon. The
+// property JSON configures the extension under test; an empty string means
+// no properties. The commands sent by Drive are named "benchmark" unless
+// overridden with SetCmdName.
+func NewExtensionBenchmark(
+	addonName string,
+	propertyJSON string,
+) (*ExtensionBenchmark, error) {
+	if len(addonName) == 0 {
+		return nil, NewTenError(
+			ErrorCodeInvalidArgument,
+			"addonName is required.",
+		)
+	}
+
+	if len(propertyJSON) == 0 {
+		propertyJSON = "{}"
+	}
+
+	return &ExtensionBenchmark{
+		addonName:    addonName,
+		propertyJSON: propertyJSON,
+		cmdName:      "benchmark",
+	}, nil
+}
+
+// SetCmdName overrides the name of the commands Drive sends, for extensions
+// that dispatch on the command name.
+func (p *ExtensionBenchmark) SetCmdName(name string) error {
+	if len(name) == 0 {
+		return NewTenError(ErrorCodeInvalidArgument, "name is required.")
+	}
+
+	p.cmdName = name
+	return nil
+}
+
+// SetTimeout bounds each Drive call; zero keeps the tester default.
+func (p *ExtensionBenchmark) SetTimeout(timeout time.Duration) {
+	p.timeout = timeout
+}
+
+// benchmarkDriver is the tester that Drive installs: on start it fires n
+// commands at the extension under test, records each round-trip latency,
+// and stops the test once the last result arrives.
+type benchmarkDriver struct {
+	DefaultExtensionTester
+
+	cmdName string
+	n       int
+
+	mu        sync.Mutex
+	latencies []time.Duration
+	firstErr  error
+	completed int
+}
+
+func (p *benchmarkDriver) OnStart(tenEnv TenEnvTester) {
+	for i := 0; i < p.n; i++ {
+		cmd, err := NewCmd(p.cmdName)
+		if err != nil {
+			p.settle(tenEnv, 0, err)
+			tenEnv.OnStartDone()
+			return
+		}
+
+		start := time.Now()
+		err = tenEnv.SendCmd(
+			cmd,
+			func(tenEnv TenEnvTester, result CmdResult, err error) {
+				p.settle(tenEnv, time.Since(start), err)
+			},
+		)
+		if err != nil {
+			p.settle(tenEnv, 0, err)
+			tenEnv.OnStartDone()
+			return
+		}
+	}
+
+	tenEnv.OnStartDone()
+}
+
+// settle records one finished command and stops the test when every command
+// has either returned a result or failed.
+func (p *benchmarkDriver) settle(
+	tenEnv TenEnvTester,
+	latency time.Duration,
+	err error,
+) {
+	p.mu.Lock()
+
+	if err != nil {
+		if p.firstErr == nil {
+			p.firstErr = err
+		}
+	} else {
+		p.latencies = append(p.latencies, latency)
+	}
+
+	p.completed++
+	done := p.completed >= p.n
+	p.mu.Unlock()
+
+	if done {
+		tenEnv.StopTest(nil)
+	}
+}
+
+// Drive sends n commands to the extension and reports throughput and latency
+// percentiles. It fails if the tester can not be set up, if the run times
+// out, or if any command comes back with an error.
+func (p *ExtensionBenchmark) Drive(n int) (BenchmarkReport, error) {
+	if n <= 0 {
+		return BenchmarkReport{}, NewTenError(
+			ErrorCodeInvalidArgument,
+			"n must be positive.",
+		)
+	}
+
+	driver := &benchmarkDriver{
+		cmdName: p.cmdName,
+		n:       n,
+	}
+
+	tester, err := NewExtensionTester(driver)
+	if err != nil {
+		return BenchmarkReport{}, err
+	}
+	defer tester.Close()
+
+	if err := tester.SetTestModeSingle(
+		p.addonName,
+		p.propertyJSON,
+	); err != nil {
+		return BenchmarkReport{}, err
+	}
+
+	if p.timeout > 0 {
+		if err := tester.SetTimeout(p.timeout); err != nil {
+			return BenchmarkReport{}, err
+		}
+	}
+
+	start := time.Now()
+	if err := tester.Run(); err != nil {
+		return BenchmarkReport{}, err
+	}
+	elapsed := time.Since(start)
+
+	driver.mu.Lock()
+	defer driver.mu.Unlock()
+
+	if driver.firstErr != nil {
+		return BenchmarkReport{}, driver.firstErr
+	}
+
+	return buildBenchmarkReport(driver.latencies, elapsed), nil
+}
+
+// buildBenchmarkReport computes the report from the recorded round trips.
+// It is separated from Drive so the statistics are testable without running
+// an extension.
+func buildBenchmarkReport(
+	latencies []time.Duration,
+	elapsed time.Duration,
+) BenchmarkReport {
+	report := BenchmarkReport{
+		Requests: len(latencies),
+		Elapsed:  elapsed,
+	}
+
+	if len(latencies) == 0 {
+		return report
+	}
+
+	if elapsed > 0 {
+		report.QPS = float64(len(latencies)) / elapsed.Seconds()
+	}
+
+	sorted := make([]time.Duration, len(latencies))
+	copy(sorted, latencies)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+
+	report.P50 = latencyPercentile(sorted, 50)
+	report.P95 = latencyPercentile(sorted, 95)
+	report.P99 = latencyPercentile(sorted, 99)
+
+	return report
+}
+
+// latencyPercentile returns the pct-th percentile of a sorted slice, using
+// the nearest-rank method.
+func latencyPercentile(sorted []time.Duration, pct float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+
+	rank := int(pct / 100 * float64(len(sorted)))
+	if rank >= len(sorted) {
+		rank = len(sorted) - 1
+	}
+
+	return sorted[rank]
+}
@@ -0,0 +1,62 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBuildBenchmarkReportPercentiles(t *testing.T) {
+	latencies := make([]time.Duration, 100)
+	for i := range latencies {
+		latencies[i] = time.Duration(i+1) * time.Millisecond
+	}
+
+	report := buildBenchmarkReport(latencies, time.Second)
+
+	if report.Requests != 100 {
+		t.FailNow()
+	}
+	if report.QPS != 100 {
+		t.FailNow()
+	}
+	if report.P50 != 51*time.Millisecond {
+		t.FailNow()
+	}
+	if report.P95 != 96*time.Millisecond {
+		t.FailNow()
+	}
+	if report.P99 != 100*time.Millisecond {
+		t.FailNow()
+	}
+}
+
+func TestBuildBenchmarkReportEmpty(t *testing.T) {
+	report := buildBenchmarkReport(nil, time.Second)
+
+	if report.Requests != 0 || report.QPS != 0 || report.P99 != 0 {
+		t.FailNow()
+	}
+}
+
+func TestLatencyPercentileSingleSample(t *testing.T) {
+	sorted := []time.Duration{7 * time.Millisecond}
+
+	for _, pct := range []float64{50, 95, 99} {
+		if latencyPercentile(sorted, pct) != 7*time.Millisecond {
+			t.FailNow()
+		}
+	}
+}
+
+func TestNewExtensionBenchmarkRequiresAddon(t *testing.T) {
+	if _, err := NewExtensionBenchmark("", "{}"); err == nil {
+		t.FailNow()
+	}
+}
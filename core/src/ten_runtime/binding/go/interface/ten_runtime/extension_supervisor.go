@@ -0,0 +1,370 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// SupervisionPolicy configures how a supervised extension reacts to a panic
+// escaping one of its callbacks.
+type SupervisionPolicy struct {
+	// MaxRestarts bounds how many times the instance is recreated before
+	// the supervisor gives up; zero means restart forever.
+	MaxRestarts int
+
+	// InitialBackoff is the delay before the first recreation; it doubles
+	// on every subsequent failure. Zero defaults to one second.
+	InitialBackoff time.Duration
+
+	// MaxBackoff caps the doubling; zero defaults to one minute.
+	MaxBackoff time.Duration
+}
+
+// supervisorState tracks restart accounting separately from the extension
+// wrapper so the backoff arithmetic is testable on its own.
+type supervisorState struct {
+	policy   SupervisionPolicy
+	restarts int
+	next     time.Duration
+}
+
+func newSupervisorState(policy SupervisionPolicy) *supervisorState {
+	if policy.InitialBackoff <= 0 {
+		policy.InitialBackoff = time.Second
+	}
+	if policy.MaxBackoff <= 0 {
+		policy.MaxBackoff = time.Minute
+	}
+
+	return &supervisorState{
+		policy: policy,
+		next:   policy.InitialBackoff,
+	}
+}
+
+// nextRestart returns the backoff to wait before the next recreation, or
+// false when the restart budget is exhausted.
+func (p *supervisorState) nextRestart() (time.Duration, bool) {
+	if p.policy.MaxRestarts > 0 && p.restarts >= p.policy.MaxRestarts {
+		return 0, false
+	}
+
+	backoff := p.next
+	p.restarts++
+
+	p.next *= 2
+	if p.next > p.policy.MaxBackoff {
+		p.next = p.policy.MaxBackoff
+	}
+
+	return backoff, true
+}
+
+// supervisedExtension wraps a user extension and recreates it when a panic
+// escapes one of its callbacks, so a transient failure in one extension does
+// not require restarting the whole process.
+type supervisedExtension struct {
+	factory func() Extension
+	state   *supervisorState
+
+	mu         sync.Mutex
+	inner      Extension
+	recreating bool
+	gaveUp     bool
+
+	// The lifecycle stages the runtime has already driven; a replacement
+	// instance has them re-driven before it goes live.
+	configured  bool
+	initialized bool
+	started     bool
+
+	// closeCh cancels a pending backoff when the extension is deinitialized,
+	// so the recreation goroutine can not outlive the extension.
+	closeCh chan struct{}
+	closed  bool
+}
+
+var _ Extension = new(supervisedExtension)
+
+// NewSupervisedExtension wraps the extensions produced by factory with a
+// restart-on-panic supervisor. It is meant to be applied where the addon
+// constructs its extension instance, so the policy is fixed at registration.
+//
+// State implications of a restart: the replacement instance is freshly
+// constructed, and the lifecycle callbacks the runtime had already driven
+// (OnConfigure/OnInit/OnStart) are re-driven on it — with their Done
+// acknowledgments suppressed, since the C runtime is past those stages — so
+// the replacement initializes itself the same way the original did. The
+// replay runs on the supervisor's recreation goroutine, not the extension
+// thread. Any in-memory state the failed instance accumulated after those
+// callbacks is lost, and messages arriving during the backoff window are
+// dropped with a log line. A replacement that panics during the replay
+// counts against the restart budget like any other failure.
+func NewSupervisedExtension(
+	factory func() Extension,
+	policy SupervisionPolicy,
+) (Extension, error) {
+	if factory == nil {
+		return nil, NewTenError(
+			ErrorCodeInvalidArgument,
+			"factory is required.",
+		)
+	}
+
+	inner := factory()
+	if inner == nil {
+		return nil, NewTenError(
+			ErrorCodeInvalidArgument,
+			"factory returned a nil extension.",
+		)
+	}
+
+	return &supervisedExtension{
+		factory: factory,
+		state:   newSupervisorState(policy),
+		inner:   inner,
+		closeCh: make(chan struct{}),
+	}, nil
+}
+
+// current returns the live instance, or nil when the extension is between
+// restarts or the supervisor has given up.
+func (p *supervisedExtension) current() Extension {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.recreating || p.gaveUp {
+		return nil
+	}
+
+	return p.inner
+}
+
+// dispatch runs fn against the live instance, turning an escaping panic into
+// a recreation of the instance. done, when non-nil, is the lifecycle
+// completion call that must reach the runtime even if fn panicked, so the
+// lifecycle state machine is not wedged by the failure.
+func (p *supervisedExtension) dispatch(
+	tenEnv TenEnv,
+	done func(),
+	fn func(inner Extension),
+) {
+	inner := p.current()
+	if inner == nil {
+		tenEnv.LogWarn(
+			"supervised extension is restarting; the callback is dropped",
+		)
+		if done != nil {
+			done()
+		}
+		return
+	}
+
+	defer func() {
+		recovered := recover()
+		if recovered == nil {
+			return
+		}
+
+		tenEnv.LogError(fmt.Sprintf(
+			"supervised extension panicked: %v",
+			recovered,
+		))
+
+		p.scheduleRecreate(tenEnv)
+
+		if done != nil {
+			done()
+		}
+	}()
+
+	fn(inner)
+}
+
+// scheduleRecreate swaps in a fresh instance after the policy's backoff, or
+// marks the supervisor as given up when the restart budget is spent.
+func (p *supervisedExtension) scheduleRecreate(tenEnv TenEnv) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.recreating || p.gaveUp {
+		return
+	}
+
+	backoff, ok := p.state.nextRestart()
+	if !ok {
+		p.gaveUp = true
+		tenEnv.LogError(
+			"supervised extension exceeded its restart budget; giving up",
+		)
+		return
+	}
+
+	p.recreating = true
+	p.inner = nil
+
+	go func() {
+		select {
+		case <-time.After(backoff):
+		case <-p.closeCh:
+			// The extension was deinitialized during the backoff; there is
+			// nothing left to recreate.
+			return
+		}
+
+		inner := p.factory()
+		if inner == nil {
+			p.mu.Lock()
+			p.recreating = false
+			p.gaveUp = true
+			p.mu.Unlock()
+			return
+		}
+
+		if !p.replayLifecycle(tenEnv, inner) {
+			// The replacement failed during the replay; treat it like any
+			// other failure and let the budget decide whether to try again.
+			p.mu.Lock()
+			p.recreating = false
+			p.mu.Unlock()
+
+			p.scheduleRecreate(tenEnv)
+			return
+		}
+
+		p.mu.Lock()
+		p.inner = inner
+		p.recreating = false
+		p.mu.Unlock()
+	}()
+}
+
+// replayTenEnv re-drives lifecycle callbacks on a replacement instance. The
+// C runtime is already past those stages, so the Done acknowledgments the
+// callbacks make must not reach it again.
+type replayTenEnv struct {
+	TenEnv
+}
+
+func (p replayTenEnv) OnConfigureDone() error { return nil }
+func (p replayTenEnv) OnInitDone() error      { return nil }
+func (p replayTenEnv) OnStartDone() error     { return nil }
+
+// replayLifecycle drives the already-passed lifecycle stages on a
+// replacement instance, so it initializes itself the way the original did
+// instead of serving messages unconfigured. It reports false when the
+// replacement panics during the replay.
+func (p *supervisedExtension) replayLifecycle(
+	tenEnv TenEnv,
+	inner Extension,
+) (ok bool) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			tenEnv.LogError(fmt.Sprintf(
+				"supervised extension panicked during lifecycle replay: %v",
+				recovered,
+			))
+			ok = false
+		}
+	}()
+
+	p.mu.Lock()
+	configured, initialized, started := p.configured, p.initialized, p.started
+	p.mu.Unlock()
+
+	replay := replayTenEnv{TenEnv: tenEnv}
+	if configured {
+		inner.OnConfigure(replay)
+	}
+	if initialized {
+		inner.OnInit(replay)
+	}
+	if started {
+		inner.OnStart(replay)
+	}
+
+	return true
+}
+
+// markStage records that the runtime has driven a lifecycle stage, under the
+// supervisor's lock.
+func (p *supervisedExtension) markStage(stage *bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	*stage = true
+}
+
+func (p *supervisedExtension) OnConfigure(tenEnv TenEnv) {
+	p.markStage(&p.configured)
+	p.dispatch(tenEnv, func() { tenEnv.OnConfigureDone() }, func(inner Extension) {
+		inner.OnConfigure(tenEnv)
+	})
+}
+
+func (p *supervisedExtension) OnInit(tenEnv TenEnv) {
+	p.markStage(&p.initialized)
+	p.dispatch(tenEnv, func() { tenEnv.OnInitDone() }, func(inner Extension) {
+		inner.OnInit(tenEnv)
+	})
+}
+
+func (p *supervisedExtension) OnStart(tenEnv TenEnv) {
+	p.markStage(&p.started)
+	p.dispatch(tenEnv, func() { tenEnv.OnStartDone() }, func(inner Extension) {
+		inner.OnStart(tenEnv)
+	})
+}
+
+func (p *supervisedExtension) OnStop(tenEnv TenEnv) {
+	p.dispatch(tenEnv, func() { tenEnv.OnStopDone() }, func(inner Extension) {
+		inner.OnStop(tenEnv)
+	})
+}
+
+func (p *supervisedExtension) OnDeinit(tenEnv TenEnv) {
+	// Cancel a pending backoff first: once the extension is torn down there
+	// is nothing to recreate, and the goroutine must not linger.
+	p.mu.Lock()
+	if !p.closed {
+		p.closed = true
+		close(p.closeCh)
+	}
+	p.mu.Unlock()
+
+	p.dispatch(tenEnv, func() { tenEnv.OnDeinitDone() }, func(inner Extension) {
+		inner.OnDeinit(tenEnv)
+	})
+}
+
+func (p *supervisedExtension) OnCmd(tenEnv TenEnv, cmd Cmd) {
+	p.dispatch(tenEnv, nil, func(inner Extension) {
+		inner.OnCmd(tenEnv, cmd)
+	})
+}
+
+func (p *supervisedExtension) OnData(tenEnv TenEnv, data Data) {
+	p.dispatch(tenEnv, nil, func(inner Extension) {
+		inner.OnData(tenEnv, data)
+	})
+}
+
+func (p *supervisedExtension) OnVideoFrame(tenEnv TenEnv, videoFrame VideoFrame) {
+	p.dispatch(tenEnv, nil, func(inner Extension) {
+		inner.OnVideoFrame(tenEnv, videoFrame)
+	})
+}
+
+func (p *supervisedExtension) OnAudioFrame(tenEnv TenEnv, audioFrame AudioFrame) {
+	p.dispatch(tenEnv, nil, func(inner Extension) {
+		inner.OnAudioFrame(tenEnv, audioFrame)
+	})
+}
@@ -0,0 +1,207 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSupervisorStateBackoffDoubles(t *testing.T) {
+	state := newSupervisorState(SupervisionPolicy{
+		InitialBackoff: time.Second,
+		MaxBackoff:     3 * time.Second,
+	})
+
+	want := []time.Duration{time.Second, 2 * time.Second, 3 * time.Second}
+	for _, expected := range want {
+		backoff, ok := state.nextRestart()
+		if !ok || backoff != expected {
+			t.FailNow()
+		}
+	}
+}
+
+func TestSupervisorStateBudgetExhausted(t *testing.T) {
+	state := newSupervisorState(SupervisionPolicy{MaxRestarts: 2})
+
+	for i := 0; i < 2; i++ {
+		if _, ok := state.nextRestart(); !ok {
+			t.FailNow()
+		}
+	}
+
+	if _, ok := state.nextRestart(); ok {
+		t.FailNow()
+	}
+}
+
+type panickyExtension struct {
+	DefaultExtension
+
+	panics bool
+	calls  *int
+}
+
+func (p *panickyExtension) OnCmd(tenEnv TenEnv, cmd Cmd) {
+	*p.calls++
+	if p.panics {
+		panic("boom")
+	}
+}
+
+func TestSupervisedExtensionRecreatesAfterPanic(t *testing.T) {
+	calls := 0
+	created := 0
+
+	ext, err := NewSupervisedExtension(func() Extension {
+		created++
+		// Only the first instance panics; the replacement is healthy.
+		return &panickyExtension{panics: created == 1, calls: &calls}
+	}, SupervisionPolicy{InitialBackoff: time.Millisecond})
+	if err != nil {
+		t.FailNow()
+	}
+
+	env := newAppTesterTenEnv()
+
+	ext.OnCmd(env, nil)
+	if calls != 1 {
+		t.FailNow()
+	}
+
+	// During the backoff window the callback is dropped.
+	ext.OnCmd(env, nil)
+	if calls != 1 {
+		t.FailNow()
+	}
+
+	// After the backoff the fresh instance serves callbacks again.
+	time.Sleep(20 * time.Millisecond)
+	ext.OnCmd(env, nil)
+	if calls != 2 || created != 2 {
+		t.FailNow()
+	}
+}
+
+func TestSupervisedExtensionGivesUp(t *testing.T) {
+	calls := 0
+
+	ext, err := NewSupervisedExtension(func() Extension {
+		return &panickyExtension{panics: true, calls: &calls}
+	}, SupervisionPolicy{
+		MaxRestarts:    1,
+		InitialBackoff: time.Millisecond,
+	})
+	if err != nil {
+		t.FailNow()
+	}
+
+	env := newAppTesterTenEnv()
+
+	ext.OnCmd(env, nil)
+	time.Sleep(20 * time.Millisecond)
+	ext.OnCmd(env, nil)
+	time.Sleep(20 * time.Millisecond)
+
+	// The budget of one restart is spent; further callbacks are dropped.
+	before := calls
+	ext.OnCmd(env, nil)
+	if calls != before {
+		t.FailNow()
+	}
+}
+
+type restartableExtension struct {
+	DefaultExtension
+
+	panics bool
+	starts *int
+	calls  *int
+}
+
+func (p *restartableExtension) OnStart(tenEnv TenEnv) {
+	*p.starts++
+	tenEnv.OnStartDone()
+}
+
+func (p *restartableExtension) OnCmd(tenEnv TenEnv, cmd Cmd) {
+	*p.calls++
+	if p.panics {
+		panic("boom")
+	}
+}
+
+func TestSupervisedExtensionReplaysLifecycle(t *testing.T) {
+	starts, calls, created := 0, 0, 0
+
+	ext, err := NewSupervisedExtension(func() Extension {
+		created++
+		return &restartableExtension{
+			panics: created == 1,
+			starts: &starts,
+			calls:  &calls,
+		}
+	}, SupervisionPolicy{InitialBackoff: time.Millisecond})
+	if err != nil {
+		t.FailNow()
+	}
+
+	env := newAppTesterTenEnv()
+
+	ext.OnStart(env)
+	if starts != 1 {
+		t.FailNow()
+	}
+
+	ext.OnCmd(env, nil)
+	time.Sleep(20 * time.Millisecond)
+
+	// The replacement had the passed OnStart stage re-driven before going
+	// live.
+	if starts != 2 {
+		t.FailNow()
+	}
+
+	ext.OnCmd(env, nil)
+	if calls != 2 {
+		t.FailNow()
+	}
+}
+
+func TestSupervisedExtensionDeinitCancelsBackoff(t *testing.T) {
+	calls, created := 0, 0
+
+	ext, err := NewSupervisedExtension(func() Extension {
+		created++
+		return &panickyExtension{panics: true, calls: &calls}
+	}, SupervisionPolicy{InitialBackoff: 5 * time.Millisecond})
+	if err != nil {
+		t.FailNow()
+	}
+
+	env := newAppTesterTenEnv()
+
+	ext.OnCmd(env, nil)
+	ext.OnDeinit(env)
+	time.Sleep(20 * time.Millisecond)
+
+	// The backoff was canceled at deinit; no replacement was constructed.
+	if created != 1 {
+		t.FailNow()
+	}
+}
+
+func TestNewSupervisedExtensionRequiresFactory(t *testing.T) {
+	if _, err := NewSupervisedExtension(
+		nil,
+		SupervisionPolicy{},
+	); err == nil {
+		t.FailNow()
+	}
+}
@@ -11,9 +11,11 @@ package ten_runtime
 import "C"
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
 	"runtime"
+	"sync"
 	"time"
 	"unsafe"
 )
@@ -75,13 +77,46 @@ func (p *DefaultExtensionTester) OnVideoFrame(
 type extTester struct {
 	IExtensionTester
 	baseTenObject[*C.ten_go_extension_tester_t]
+
+	closeOnce sync.Once
+
+	// Teardown health, reported through Run's returned error: whether the
+	// tester's OnStop was dispatched, the env it ran against (to check that
+	// OnStopDone was called), and any panic recovered during teardown.
+	teardownMu    sync.Mutex
+	onStopSeen    bool
+	teardownEnv   *tenEnvTester
+	teardownPanic any
+
+	// cleanups registered by tester features, run once by Close. See
+	// addCleanup.
+	cleanups []func()
 }
 
 // ExtensionTester is the interface for the extension tester.
+//
+// A tester is single-use: configure it, call Run once, then Close. The app
+// hosting the testers is reusable; a test suite can create and run many
+// testers against it sequentially. Testers are not safe for concurrent use
+// from multiple goroutines.
 type ExtensionTester interface {
 	SetTestModeSingle(addonName string, propertyJSONStr string) error
+
+	// SetTestModeSingleStruct is SetTestModeSingle with the property JSON
+	// marshaled from a Go value, so typed configs do not have to be
+	// hand-written as escaped string literals.
+	SetTestModeSingleStruct(addonName string, config any) error
+
 	SetTimeout(timeout time.Duration) error
 	Run() error
+
+	// Close releases the tester deterministically — the native part and
+	// every resource a tester feature registered — so large test suites do
+	// not depend on the garbage collector to reclaim them between subtests.
+	// Always `defer tester.Close()` right after creation; relying on the
+	// finalizer leaks files and channels until some later GC cycle. Close
+	// is idempotent; the tester can not be used after it.
+	Close() error
 }
 
 var _ ExtensionTester = new(extTester)
@@ -101,6 +136,21 @@ func (p *extTester) SetTestModeSingle(
 	return withCGoError(&cStatus)
 }
 
+func (p *extTester) SetTestModeSingleStruct(
+	addonName string,
+	config any,
+) error {
+	propertyJSONBytes, err := json.Marshal(config)
+	if err != nil {
+		return NewTenError(
+			ErrorCodeInvalidJSON,
+			"failed to marshal the config: "+err.Error(),
+		)
+	}
+
+	return p.SetTestModeSingle(addonName, string(propertyJSONBytes))
+}
+
 func (p *extTester) SetTimeout(timeout time.Duration) error {
 	cStatus := C.ten_go_extension_tester_set_timeout(
 		p.cPtr,
@@ -113,7 +163,68 @@ func (p *extTester) SetTimeout(timeout time.Duration) error {
 func (p *extTester) Run() error {
 	cStatus := C.ten_go_extension_tester_run(p.cPtr)
 
-	return withCGoError(&cStatus)
+	if err := withCGoError(&cStatus); err != nil {
+		return err
+	}
+
+	return p.teardownError()
+}
+
+// teardownError reports teardown health after a run that otherwise passed:
+// a panic during OnStop/OnDeinit, a teardown that never reached OnStop, or
+// an OnStop that never called OnStopDone all fail the test, so lifecycle
+// regressions do not hide behind a green happy path.
+func (p *extTester) teardownError() error {
+	p.teardownMu.Lock()
+	defer p.teardownMu.Unlock()
+
+	if p.teardownPanic != nil {
+		return NewTenError(
+			ErrorCodeGeneric,
+			fmt.Sprintf(
+				"the tester panicked during teardown: %v",
+				p.teardownPanic,
+			),
+		)
+	}
+
+	if !p.onStopSeen {
+		return NewTenError(
+			ErrorCodeGeneric,
+			"the tester never reached OnStop.",
+		)
+	}
+
+	if p.teardownEnv != nil &&
+		p.teardownEnv.Invocations()["OnStopDone"] == 0 {
+		return NewTenError(
+			ErrorCodeGeneric,
+			"the tester's OnStop never called OnStopDone.",
+		)
+	}
+
+	return nil
+}
+
+// recordTeardownPanic keeps the first panic recovered during teardown for
+// teardownError.
+func (p *extTester) recordTeardownPanic(recovered any) {
+	p.teardownMu.Lock()
+	defer p.teardownMu.Unlock()
+
+	if p.teardownPanic == nil {
+		p.teardownPanic = recovered
+	}
+}
+
+func (p *extTester) Close() error {
+	p.closeOnce.Do(func() {
+		runtime.SetFinalizer(p, nil)
+		p.runCleanups()
+		C.ten_go_extension_tester_finalize(p.cPtr)
+	})
+
+	return nil
 }
 
 // NewExtensionTester creates a new extension tester.
@@ -149,7 +260,7 @@ func NewExtensionTester(
 	)
 
 	runtime.SetFinalizer(extTesterInstance, func(p *extTester) {
-		C.ten_go_extension_tester_finalize(p.cPtr)
+		p.Close()
 	})
 
 	return extTesterInstance, nil
@@ -180,6 +291,10 @@ func tenGoExtensionTesterOnStart(
 		)
 	}
 
+	if te, ok := tenEnvTesterObj.(*tenEnvTester); ok {
+		te.recordInvocation("OnStart")
+	}
+
 	extTesterObj.OnStart(tenEnvTesterObj)
 }
 
@@ -208,6 +323,23 @@ func tenGoExtensionTesterOnStop(
 		)
 	}
 
+	extTesterObj.teardownMu.Lock()
+	extTesterObj.onStopSeen = true
+	if te, ok := tenEnvTesterObj.(*tenEnvTester); ok {
+		extTesterObj.teardownEnv = te
+		te.recordInvocation("OnStop")
+	}
+	extTesterObj.teardownMu.Unlock()
+
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			extTesterObj.recordTeardownPanic(recovered)
+			// Keep the teardown state machine moving; the panic is
+			// reported through Run's returned error.
+			tenEnvTesterObj.OnStopDone()
+		}
+	}()
+
 	extTesterObj.OnStop(tenEnvTesterObj)
 }
 
@@ -236,6 +368,17 @@ func tenGoExtensionTesterOnDeinit(
 		)
 	}
 
+	if te, ok := tenEnvTesterObj.(*tenEnvTester); ok {
+		te.recordInvocation("OnDeinit")
+	}
+
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			extTesterObj.recordTeardownPanic(recovered)
+			tenEnvTesterObj.OnDeinitDone()
+		}
+	}()
+
 	extTesterObj.OnDeinit(tenEnvTesterObj)
 }
 
@@ -267,6 +410,10 @@ func tenGoExtensionTesterOnCmd(
 
 	// The GO cmd object should be created in GO side, and managed by the GO GC.
 	customCmd := newCmd(cmdBridge)
+	if te, ok := tenEnvTesterObj.(*tenEnvTester); ok {
+		te.recordInvocation("OnCmd")
+	}
+
 	extTesterObj.OnCmd(tenEnvTesterObj, customCmd)
 }
 
@@ -299,6 +446,10 @@ func tenGoExtensionTesterOnData(
 	// The GO data object should be created in GO side, and managed by the GO
 	// GC.
 	customData := newData(dataBridge)
+	if te, ok := tenEnvTesterObj.(*tenEnvTester); ok {
+		te.recordInvocation("OnData")
+	}
+
 	extTesterObj.OnData(tenEnvTesterObj, customData)
 }
 
@@ -331,6 +482,10 @@ func tenGoExtensionTesterOnAudioFrame(
 	// The GO audio_frame object should be created in GO side, and managed by
 	// the GO GC.
 	customAudioFrame := newAudioFrame(audioFrameBridge)
+	if te, ok := tenEnvTesterObj.(*tenEnvTester); ok {
+		te.recordInvocation("OnAudioFrame")
+	}
+
 	extTesterObj.OnAudioFrame(tenEnvTesterObj, customAudioFrame)
 }
 
@@ -363,5 +518,9 @@ func tenGoExtensionTesterOnVideoFrame(
 	// The GO video_frame object should be created in GO side, and managed by
 	// the GO GC.
 	customVideoFrame := newVideoFrame(videoFrameBridge)
+	if te, ok := tenEnvTesterObj.(*tenEnvTester); ok {
+		te.recordInvocation("OnVideoFrame")
+	}
+
 	extTesterObj.OnVideoFrame(tenEnvTesterObj, customVideoFrame)
 }
@@ -0,0 +1,71 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"fmt"
+	"strings"
+)
+
+// addCleanup registers a cleanup to run exactly once when the tester is
+// closed. Tester features that hold files, channels or goroutines must hang
+// their release off the tester this way, so one deferred Close covers every
+// resource of a run and large suites do not accumulate leaks.
+func (p *extTester) addCleanup(cleanup func()) {
+	p.teardownMu.Lock()
+	defer p.teardownMu.Unlock()
+
+	p.cleanups = append(p.cleanups, cleanup)
+}
+
+// runCleanups runs the registered cleanups in reverse registration order and
+// drops the references the tester holds onto, so a closed tester keeps
+// nothing alive.
+func (p *extTester) runCleanups() {
+	p.teardownMu.Lock()
+	cleanups := p.cleanups
+	p.cleanups = nil
+	p.teardownEnv = nil
+	p.teardownMu.Unlock()
+
+	for i := len(cleanups) - 1; i >= 0; i-- {
+		cleanups[i]()
+	}
+}
+
+// AssertNoTesterLeaks verifies that no tester bridge objects are alive, for
+// a suite's TestMain or a final sanity test after many tester.Run cycles. A
+// non-nil error lists the leaked handle types; the usual cause is a tester
+// whose Close was not deferred.
+func AssertNoTesterLeaks() error {
+	var leaked []string
+	for name, count := range DebugHandleCounts() {
+		if !strings.Contains(name, "extTester") &&
+			!strings.Contains(name, "tenEnvTester") {
+			continue
+		}
+		if count > 0 {
+			leaked = append(
+				leaked,
+				fmt.Sprintf("%s: %d", name, count),
+			)
+		}
+	}
+
+	if len(leaked) == 0 {
+		return nil
+	}
+
+	return NewTenError(
+		ErrorCodeGeneric,
+		fmt.Sprintf(
+			"live tester handles after close: %s.",
+			strings.Join(leaked, ", "),
+		),
+	)
+}
@@ -0,0 +1,55 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRunCleanupsReverseOrderAndOnce(t *testing.T) {
+	tester := &extTester{}
+
+	var order []string
+	tester.addCleanup(func() { order = append(order, "first") })
+	tester.addCleanup(func() { order = append(order, "second") })
+
+	tester.runCleanups()
+	if len(order) != 2 || order[0] != "second" || order[1] != "first" {
+		t.FailNow()
+	}
+
+	// A second run finds nothing left to do.
+	tester.runCleanups()
+	if len(order) != 2 {
+		t.FailNow()
+	}
+}
+
+func TestRunCleanupsDropsTeardownEnv(t *testing.T) {
+	tester := &extTester{teardownEnv: &tenEnvTester{}}
+
+	tester.runCleanups()
+	if tester.teardownEnv != nil {
+		t.FailNow()
+	}
+}
+
+func TestAssertNoTesterLeaksReportsLiveHandles(t *testing.T) {
+	if err := AssertNoTesterLeaks(); err != nil {
+		t.FailNow()
+	}
+
+	id := newGoHandle(&tenEnvTester{})
+	defer loadAndDeleteGoHandle(id)
+
+	err := AssertNoTesterLeaks()
+	if err == nil || !strings.Contains(err.Error(), "tenEnvTester") {
+		t.FailNow()
+	}
+}
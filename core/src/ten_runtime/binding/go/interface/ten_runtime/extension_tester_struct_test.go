@@ -0,0 +1,30 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSetTestModeSingleStructRejectsUnmarshalable(t *testing.T) {
+	tester := &extTester{}
+
+	err := tester.SetTestModeSingleStruct("some_addon", make(chan int))
+	if err == nil {
+		t.FailNow()
+	}
+
+	var tenErr *TenError
+	if !errors.As(err, &tenErr) {
+		t.FailNow()
+	}
+	if tenErr.ErrorCode != ErrorCodeInvalidJSON {
+		t.FailNow()
+	}
+}
@@ -0,0 +1,62 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTeardownErrorWithoutOnStop(t *testing.T) {
+	tester := &extTester{}
+
+	err := tester.teardownError()
+	if err == nil {
+		t.FailNow()
+	}
+	if !strings.Contains(err.Error(), "never reached OnStop") {
+		t.FailNow()
+	}
+}
+
+func TestTeardownErrorPanicTakesPrecedence(t *testing.T) {
+	tester := &extTester{}
+	tester.recordTeardownPanic("boom")
+	tester.onStopSeen = true
+
+	err := tester.teardownError()
+	if err == nil || !strings.Contains(err.Error(), "boom") {
+		t.FailNow()
+	}
+}
+
+func TestTeardownErrorMissingOnStopDone(t *testing.T) {
+	env := &tenEnvTester{}
+	tester := &extTester{onStopSeen: true, teardownEnv: env}
+
+	err := tester.teardownError()
+	if err == nil ||
+		!strings.Contains(err.Error(), "never called OnStopDone") {
+		t.FailNow()
+	}
+
+	env.recordInvocation("OnStopDone")
+	if tester.teardownError() != nil {
+		t.FailNow()
+	}
+}
+
+func TestRecordTeardownPanicKeepsFirst(t *testing.T) {
+	tester := &extTester{}
+	tester.recordTeardownPanic("first")
+	tester.recordTeardownPanic("second")
+
+	if tester.teardownPanic != "first" {
+		t.FailNow()
+	}
+}
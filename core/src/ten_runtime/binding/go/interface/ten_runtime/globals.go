@@ -31,6 +31,10 @@ const (
 // no exported apis for us.
 func EnsureCleanupWhenProcessExit() {
 	// TODO(Liu): check if the handle map is empty.
+
+	// The process is about to exit; push out any log records that are still
+	// buffered so the final lines are not lost.
+	FlushLogs()
 }
 
 // @}
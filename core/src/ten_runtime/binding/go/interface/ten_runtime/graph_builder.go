@@ -0,0 +1,227 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Message kinds of a graph connection, matching the keys of the graph json.
+const (
+	graphMsgKindCmd        = "cmd"
+	graphMsgKindData       = "data"
+	graphMsgKindAudioFrame = "audio_frame"
+	graphMsgKindVideoFrame = "video_frame"
+)
+
+type graphNode struct {
+	Type           string          `json:"type"`
+	Name           string          `json:"name"`
+	Addon          string          `json:"addon"`
+	ExtensionGroup string          `json:"extension_group"`
+	Property       json.RawMessage `json:"property,omitempty"`
+}
+
+type graphDest struct {
+	Extension string `json:"extension"`
+}
+
+type graphFlow struct {
+	Name string      `json:"name"`
+	Dest []graphDest `json:"dest"`
+}
+
+type graphConnection struct {
+	Extension  string      `json:"extension"`
+	Cmd        []graphFlow `json:"cmd,omitempty"`
+	Data       []graphFlow `json:"data,omitempty"`
+	AudioFrame []graphFlow `json:"audio_frame,omitempty"`
+	VideoFrame []graphFlow `json:"video_frame,omitempty"`
+}
+
+// GraphBuilder constructs the graph json consumed by start graph commands and
+// the tester, so integration tests can define their graph inline instead of
+// shipping a property.json next to the test.
+type GraphBuilder struct {
+	nodes       []graphNode
+	nodeNames   map[string]struct{}
+	connections map[string]*graphConnection
+
+	// connectionOrder keeps the emitted json deterministic.
+	connectionOrder []string
+
+	err error
+}
+
+// NewGraphBuilder creates an empty graph builder.
+func NewGraphBuilder() *GraphBuilder {
+	return &GraphBuilder{
+		nodeNames:   make(map[string]struct{}),
+		connections: make(map[string]*graphConnection),
+	}
+}
+
+// AddNode declares an extension node. The propertyJSON is the property object
+// of the node; pass "" for none. Errors are collected and reported by Build.
+func (b *GraphBuilder) AddNode(
+	addon string,
+	name string,
+	propertyJSON string,
+) *GraphBuilder {
+	if b.err != nil {
+		return b
+	}
+
+	if _, ok := b.nodeNames[name]; ok {
+		b.err = NewTenError(
+			ErrorCodeInvalidArgument,
+			fmt.Sprintf("node %q is declared twice", name),
+		)
+		return b
+	}
+
+	node := graphNode{
+		Type:           "extension",
+		Name:           name,
+		Addon:          addon,
+		ExtensionGroup: "default_extension_group",
+	}
+
+	if len(propertyJSON) > 0 {
+		if !json.Valid([]byte(propertyJSON)) {
+			b.err = NewTenError(
+				ErrorCodeInvalidJSON,
+				fmt.Sprintf("property of node %q is not valid json", name),
+			)
+			return b
+		}
+		node.Property = json.RawMessage(propertyJSON)
+	}
+
+	b.nodes = append(b.nodes, node)
+	b.nodeNames[name] = struct{}{}
+	return b
+}
+
+// Connect routes the named cmd from srcExt to destExt.
+func (b *GraphBuilder) Connect(
+	srcExt string,
+	msgName string,
+	destExt string,
+) *GraphBuilder {
+	return b.connect(graphMsgKindCmd, srcExt, msgName, destExt)
+}
+
+// ConnectData routes the named data message from srcExt to destExt.
+func (b *GraphBuilder) ConnectData(
+	srcExt string,
+	msgName string,
+	destExt string,
+) *GraphBuilder {
+	return b.connect(graphMsgKindData, srcExt, msgName, destExt)
+}
+
+// ConnectAudioFrame routes the named audio frame from srcExt to destExt.
+func (b *GraphBuilder) ConnectAudioFrame(
+	srcExt string,
+	msgName string,
+	destExt string,
+) *GraphBuilder {
+	return b.connect(graphMsgKindAudioFrame, srcExt, msgName, destExt)
+}
+
+// ConnectVideoFrame routes the named video frame from srcExt to destExt.
+func (b *GraphBuilder) ConnectVideoFrame(
+	srcExt string,
+	msgName string,
+	destExt string,
+) *GraphBuilder {
+	return b.connect(graphMsgKindVideoFrame, srcExt, msgName, destExt)
+}
+
+func (b *GraphBuilder) connect(
+	kind string,
+	srcExt string,
+	msgName string,
+	destExt string,
+) *GraphBuilder {
+	if b.err != nil {
+		return b
+	}
+
+	for _, ext := range []string{srcExt, destExt} {
+		if _, ok := b.nodeNames[ext]; !ok {
+			b.err = NewTenError(
+				ErrorCodeInvalidArgument,
+				fmt.Sprintf(
+					"connection references undeclared node %q",
+					ext,
+				),
+			)
+			return b
+		}
+	}
+
+	conn, ok := b.connections[srcExt]
+	if !ok {
+		conn = &graphConnection{Extension: srcExt}
+		b.connections[srcExt] = conn
+		b.connectionOrder = append(b.connectionOrder, srcExt)
+	}
+
+	flows := map[string]*[]graphFlow{
+		graphMsgKindCmd:        &conn.Cmd,
+		graphMsgKindData:       &conn.Data,
+		graphMsgKindAudioFrame: &conn.AudioFrame,
+		graphMsgKindVideoFrame: &conn.VideoFrame,
+	}[kind]
+
+	for i := range *flows {
+		if (*flows)[i].Name == msgName {
+			(*flows)[i].Dest = append(
+				(*flows)[i].Dest,
+				graphDest{Extension: destExt},
+			)
+			return b
+		}
+	}
+
+	*flows = append(*flows, graphFlow{
+		Name: msgName,
+		Dest: []graphDest{{Extension: destExt}},
+	})
+	return b
+}
+
+// Build validates the graph and returns its json.
+func (b *GraphBuilder) Build() ([]byte, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+
+	if len(b.nodes) == 0 {
+		return nil, NewTenError(
+			ErrorCodeInvalidArgument,
+			"the graph has no nodes",
+		)
+	}
+
+	graph := struct {
+		Nodes       []graphNode       `json:"nodes"`
+		Connections []graphConnection `json:"connections,omitempty"`
+	}{
+		Nodes: b.nodes,
+	}
+
+	for _, src := range b.connectionOrder {
+		graph.Connections = append(graph.Connections, *b.connections[src])
+	}
+
+	return json.Marshal(graph)
+}
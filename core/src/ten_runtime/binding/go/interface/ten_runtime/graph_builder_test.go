@@ -0,0 +1,59 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestGraphBuilder(t *testing.T) {
+	graphJSON, err := NewGraphBuilder().
+		AddNode("addon_a", "a", `{"port": 8000}`).
+		AddNode("addon_b", "b", "").
+		Connect("a", "hello", "b").
+		ConnectData("a", "chunk", "b").
+		Build()
+	if err != nil {
+		t.FailNow()
+	}
+
+	var graph map[string]any
+	if err := json.Unmarshal(graphJSON, &graph); err != nil {
+		t.FailNow()
+	}
+
+	nodes := graph["nodes"].([]any)
+	if len(nodes) != 2 {
+		t.FailNow()
+	}
+
+	connections := graph["connections"].([]any)
+	if len(connections) != 1 {
+		t.FailNow()
+	}
+
+	conn := connections[0].(map[string]any)
+	if conn["extension"] != "a" {
+		t.FailNow()
+	}
+	if len(conn["cmd"].([]any)) != 1 || len(conn["data"].([]any)) != 1 {
+		t.FailNow()
+	}
+}
+
+func TestGraphBuilderUndeclaredNode(t *testing.T) {
+	_, err := NewGraphBuilder().
+		AddNode("addon_a", "a", "").
+		Connect("a", "hello", "missing").
+		Build()
+	if err == nil || !strings.Contains(err.Error(), "missing") {
+		t.FailNow()
+	}
+}
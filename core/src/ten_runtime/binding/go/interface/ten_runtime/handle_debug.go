@@ -0,0 +1,60 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import "fmt"
+
+// debugHandleTypeName maps a handle value to the bucket name reported by
+// DebugHandleCounts. Well-known C-backed bridge objects get stable names;
+// anything else (callbacks, pinned Go pointers, ...) is bucketed by its Go
+// type.
+func debugHandleTypeName(obj any) string {
+	switch obj.(type) {
+	case *cmd:
+		return "Cmd"
+	case *cmdResult:
+		return "CmdResult"
+	case *data:
+		return "Data"
+	case *audioFrame:
+		return "AudioFrame"
+	case *videoFrame:
+		return "VideoFrame"
+	case *tenEnv:
+		return "TenEnv"
+	default:
+		return fmt.Sprintf("%T", obj)
+	}
+}
+
+// DebugHandleCounts returns the number of live handles currently held by the
+// Go/C bridge, grouped by type. Both the mutable handle map (cmds, results,
+// callbacks, ...) and the immutable handle map (ten envs, extensions, ...) are
+// counted.
+//
+// Every handle represents a Go object kept alive on behalf of the C world, so
+// after a workload completes the counts are expected to return to their
+// baseline; a count that only grows indicates a handle leak. The snapshot is
+// taken under the handle map locks, there is no bookkeeping overhead when this
+// function is not called.
+func DebugHandleCounts() map[string]int {
+	counts := make(map[string]int)
+
+	handles.RLock()
+	for _, v := range handles.items {
+		counts[debugHandleTypeName(v)]++
+	}
+	handles.RUnlock()
+
+	immutableHandles.Range(func(_, v any) bool {
+		counts[debugHandleTypeName(v)]++
+		return true
+	})
+
+	return counts
+}
@@ -0,0 +1,38 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"testing"
+)
+
+func TestDebugHandleCountsReturnsToBaseline(t *testing.T) {
+	type leakProbe struct{}
+
+	bucket := debugHandleTypeName(&leakProbe{})
+	baseline := DebugHandleCounts()[bucket]
+
+	ids := make([]goHandle, 0, 10)
+	for i := 0; i < 10; i++ {
+		ids = append(ids, newGoHandle(&leakProbe{}))
+	}
+
+	if got := DebugHandleCounts()[bucket]; got != baseline+10 {
+		t.FailNow()
+	}
+
+	for _, id := range ids {
+		if loadAndDeleteGoHandle(id) == nil {
+			t.FailNow()
+		}
+	}
+
+	if got := DebugHandleCounts()[bucket]; got != baseline {
+		t.FailNow()
+	}
+}
@@ -0,0 +1,65 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"log"
+	"os"
+	"sync/atomic"
+)
+
+// MemoryTrackingEnvName is the environment variable the native runtime
+// consults at process init to enable its memory leak tracker.
+const MemoryTrackingEnvName = "TEN_ENABLE_MEMORY_TRACKING"
+
+// leakCheckDisabled gates the leak report at app deinit; the zero value
+// means the check is enabled, which is the default.
+var leakCheckDisabled atomic.Bool
+
+// SetLeakCheckEnabled controls leak checking at shutdown. When disabled,
+// the binding skips its bridge-handle leak report at app deinit and the
+// native runtime's memory tracker is switched off, so tests that
+// intentionally leave handles for the OS to reclaim are not flooded with
+// spurious leak reports (and need no GC loop to quiet them).
+//
+// The native tracker reads its setting at process init; call this before
+// NewApp for it to take effect there. The default is enabled.
+func SetLeakCheckEnabled(enabled bool) {
+	leakCheckDisabled.Store(!enabled)
+
+	if !enabled {
+		// The native tracker only checks for the literal "true".
+		os.Setenv(MemoryTrackingEnvName, "false")
+	}
+}
+
+// leakedHandleCounts returns the bridge handles still alive at app deinit
+// that indicate a leak. Handles that are legitimately alive until the very
+// end of the process (the app itself and its ten env) are excluded.
+func leakedHandleCounts() map[string]int {
+	counts := DebugHandleCounts()
+	delete(counts, "TenEnv")
+	delete(counts, "*ten_runtime.app")
+	return counts
+}
+
+// reportLeakedHandles logs bridge handles still alive at app deinit, so a
+// handle leak shows up at the point the app winds down instead of as silent
+// memory growth. Gated by SetLeakCheckEnabled.
+func reportLeakedHandles() {
+	if leakCheckDisabled.Load() {
+		return
+	}
+
+	leaked := leakedHandleCounts()
+	if len(leaked) == 0 {
+		return
+	}
+
+	log.Printf("App OnDeinit with live bridge handles: %v\n", leaked)
+}
@@ -0,0 +1,47 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"os"
+	"testing"
+)
+
+func TestLeakCheckEnabledByDefault(t *testing.T) {
+	if leakCheckDisabled.Load() {
+		t.FailNow()
+	}
+}
+
+func TestSetLeakCheckEnabledToggles(t *testing.T) {
+	defer SetLeakCheckEnabled(true)
+
+	SetLeakCheckEnabled(false)
+	if !leakCheckDisabled.Load() {
+		t.FailNow()
+	}
+	if os.Getenv(MemoryTrackingEnvName) != "false" {
+		t.FailNow()
+	}
+
+	SetLeakCheckEnabled(true)
+	if leakCheckDisabled.Load() {
+		t.FailNow()
+	}
+}
+
+func TestLeakedHandleCountsReportsPinned(t *testing.T) {
+	// A pinned value that is never released must show up in the report.
+	pin := newGoHandle(&ptrPropPayload{value: 1})
+	defer loadAndDeleteGoHandle(pin)
+
+	leaked := leakedHandleCounts()
+	if leaked["*ten_runtime.ptrPropPayload"] == 0 {
+		t.FailNow()
+	}
+}
@@ -0,0 +1,89 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// liveExtensions counts the extensions of this process that are between
+// OnConfigure and OnDeinitDone. The app's OnDeinit is held back until the
+// count drains, so no extension can observe an app whose resources are
+// already gone; see tenGoAppOnDeinit.
+var liveExtensions = newLifecycleTracker()
+
+// appDeinitWaitTimeout bounds how long the app's OnDeinit waits for
+// extensions to finish deinitializing. A stuck extension should surface as a
+// loud error, not as a silent teardown hang.
+const appDeinitWaitTimeout = 30 * time.Second
+
+type lifecycleTracker struct {
+	mu    sync.Mutex
+	count int
+
+	// idle is closed and replaced whenever count drains to zero, so waiters
+	// do not poll.
+	idle chan struct{}
+}
+
+func newLifecycleTracker() *lifecycleTracker {
+	return &lifecycleTracker{idle: make(chan struct{})}
+}
+
+// begin records that an extension entered its lifecycle.
+func (t *lifecycleTracker) begin() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.count++
+}
+
+// end records that an extension completed OnDeinitDone and wakes waiters when
+// it was the last one.
+func (t *lifecycleTracker) end() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.count > 0 {
+		t.count--
+	}
+
+	if t.count == 0 {
+		close(t.idle)
+		t.idle = make(chan struct{})
+	}
+}
+
+// waitIdle blocks until no extensions are alive, or the timeout elapses, in
+// which case the number of stragglers is reported.
+func (t *lifecycleTracker) waitIdle(timeout time.Duration) error {
+	deadline := time.After(timeout)
+
+	for {
+		t.mu.Lock()
+		if t.count == 0 {
+			t.mu.Unlock()
+			return nil
+		}
+		idle := t.idle
+		remaining := t.count
+		t.mu.Unlock()
+
+		select {
+		case <-idle:
+			// Re-check: a new extension may have begun in the meantime.
+		case <-deadline:
+			return fmt.Errorf(
+				"%d extension(s) did not complete OnDeinit in time",
+				remaining,
+			)
+		}
+	}
+}
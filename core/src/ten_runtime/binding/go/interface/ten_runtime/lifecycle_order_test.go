@@ -0,0 +1,48 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLifecycleTrackerWaitIdleWhenEmpty(t *testing.T) {
+	tracker := newLifecycleTracker()
+
+	if err := tracker.waitIdle(time.Second); err != nil {
+		t.FailNow()
+	}
+}
+
+func TestLifecycleTrackerWaitsForAllEnds(t *testing.T) {
+	tracker := newLifecycleTracker()
+
+	tracker.begin()
+	tracker.begin()
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		tracker.end()
+		tracker.end()
+	}()
+
+	if err := tracker.waitIdle(time.Second); err != nil {
+		t.FailNow()
+	}
+}
+
+func TestLifecycleTrackerTimesOutOnStraggler(t *testing.T) {
+	tracker := newLifecycleTracker()
+
+	tracker.begin()
+
+	if err := tracker.waitIdle(10 * time.Millisecond); err == nil {
+		t.FailNow()
+	}
+}
@@ -0,0 +1,21 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+// #include "common.h"
+import "C"
+
+// FlushLogs forces any log records buffered by the TEN runtime out to their
+// final destination (the configured log file, or the standard streams). An app
+// that exits right after its last log call can otherwise lose those lines.
+//
+// It is safe to call FlushLogs multiple times, and it is called automatically
+// from EnsureCleanupWhenProcessExit.
+func FlushLogs() {
+	C.ten_go_log_flush()
+}
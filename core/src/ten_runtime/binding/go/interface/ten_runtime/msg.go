@@ -12,6 +12,7 @@ import "C"
 
 import (
 	"runtime"
+	"time"
 	"unsafe"
 )
 
@@ -46,9 +47,61 @@ type Msg interface {
 
 	GetName() (name string, err error)
 
+	// Name returns the message name, discarding the error for the common case
+	// where the handle is known to be valid (ex: a message received in an
+	// OnCmd/OnData callback). It returns "" only for an invalid handle; use
+	// GetName when the error matters.
+	Name() string
+
+	// SetName renames the message in place, so a received message can be
+	// forwarded under another name without creating a new message and copying
+	// the properties. The new name is validated against the runtime's naming
+	// rules.
+	SetName(name string) error
+
 	GetSource() (loc Loc, err error)
 	SetDests(locs ...Loc) (err error)
 
+	// GetReceiveTimestamp returns the time the runtime stamped on the message when
+	// it entered the engine, measured on the runtime's clock (the same clock
+	// as TenEnv.Now), so latency can be computed without mixing clocks across
+	// the cgo boundary. The zero time is returned for a message that has not
+	// been through the engine yet.
+	GetReceiveTimestamp() (time.Time, error)
+
+	// SetPriority attaches a priority level to the message; GetPriority
+	// reads it back, defaulting to PriorityNormal. Priority is best-effort
+	// metadata today; see SetPriority for the precise semantics.
+	SetPriority(level MessagePriority) error
+	GetPriority() (MessagePriority, error)
+
+	// SetMeta attaches transport metadata (trace IDs, routing hints) in a
+	// namespace separate from the user property tree; GetMeta reads it
+	// back. See SetMeta for the propagation rules.
+	SetMeta(key string, value string) error
+	GetMeta(key string) (string, error)
+
+	// ToMap returns the entire property tree as a native Go map (objects as
+	// maps, arrays as slices, numbers as json.Number); see the method doc
+	// for how bytes and null are represented.
+	ToMap() (map[string]any, error)
+
+	// SetPropertyIfAbsent sets a property only when the path holds no value
+	// yet, and reports whether it was set, so defaults never clobber values
+	// already on the message.
+	SetPropertyIfAbsent(path string, value any) (bool, error)
+
+	// Dump renders the message name and its top-level properties as
+	// human-readable text for panic messages and test failures. Large values
+	// are truncated to keep the dump bounded.
+	Dump() string
+
+	// MergeProperties deep-merges the given properties over the existing
+	// property tree: nested objects merge recursively, arrays and scalars
+	// are replaced. Useful to enrich a forwarded message without clobbering
+	// unrelated fields.
+	MergeProperties(properties map[string]any) error
+
 	iProperty
 }
 
@@ -201,6 +254,47 @@ func (p *msg) GetName() (string, error) {
 	return C.GoString(msgName), nil
 }
 
+func (p *msg) Name() string {
+	name, _ := p.GetName()
+	return name
+}
+
+func (p *msg) GetReceiveTimestamp() (time.Time, error) {
+	defer p.keepAlive()
+
+	us, _ := withCGOLimiterHasReturnValue[int64](func() (int64, error) {
+		return int64(C.ten_go_msg_get_timestamp(p.cPtr)), nil
+	})
+
+	if us == 0 {
+		// The runtime stamps the timestamp when the message enters the
+		// engine; a freshly created message has none yet.
+		return time.Time{}, nil
+	}
+
+	return time.UnixMicro(us), nil
+}
+
+func (p *msg) SetName(name string) error {
+	if len(name) == 0 {
+		return NewTenError(
+			ErrorCodeInvalidArgument,
+			"msg name is required.",
+		)
+	}
+
+	defer p.keepAlive()
+
+	return withCGOLimiter(func() error {
+		apiStatus := C.ten_go_msg_set_name(
+			p.cPtr,
+			unsafe.Pointer(unsafe.StringData(name)),
+			C.int(len(name)),
+		)
+		return withCGoError(&apiStatus)
+	})
+}
+
 func (p *msg) GetSource() (loc Loc, err error) {
 	defer p.keepAlive()
 
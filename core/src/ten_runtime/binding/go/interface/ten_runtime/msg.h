@@ -305,6 +305,23 @@ void ten_go_msg_finalize(uintptr_t bridge_addr);
 
 ten_go_error_t ten_go_msg_get_name(uintptr_t bridge_addr, const char **name);
 
+/**
+ * @brief Set the name of the msg, so a received msg can be renamed in place
+ * and relayed. The name is validated against the runtime's naming rules.
+ *
+ * @param name The underlying buffer of the GO string, only the read operation
+ * is permitted, and do not read it after the blocking cgo call.
+ */
+ten_go_error_t ten_go_msg_set_name(uintptr_t bridge_addr, const void *name,
+                                   int name_len);
+
+/**
+ * @brief Get the timestamp (in microseconds, on the runtime's clock) stamped
+ * on the msg when it entered the engine. Returns 0 for a msg that has not
+ * been through the engine yet.
+ */
+int64_t ten_go_msg_get_timestamp(uintptr_t bridge_addr);
+
 /**
  * @brief Get the source location (app_uri, graph_id, extension_name) of the
  * msg.
@@ -0,0 +1,82 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// dumpMaxValueLen bounds how much of a single property value a dump shows, so
+// a message carrying a large buffer does not flood a panic message or a test
+// log.
+const dumpMaxValueLen = 64
+
+// Dump renders the message as human-readable text: the name followed by the
+// top-level properties in key order. Long values are truncated to their
+// leading bytes plus the total length.
+func (p *msg) Dump() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "msg %q {", p.Name())
+
+	type entry struct {
+		key   string
+		value any
+	}
+
+	var entries []entry
+	err := p.RangeProperties(func(key string, value any) bool {
+		entries = append(entries, entry{key: key, value: value})
+		return true
+	})
+	if err != nil {
+		fmt.Fprintf(&b, "<properties unavailable: %s>", err.Error())
+		b.WriteString("}")
+		return b.String()
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].key < entries[j].key
+	})
+
+	for i, e := range entries {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		fmt.Fprintf(&b, "%s: %s", e.key, dumpValue(e.value))
+	}
+
+	b.WriteString("}")
+	return b.String()
+}
+
+// msg deliberately does not implement fmt.Stringer: RangeProperties crosses
+// the cgo boundary under the limiter, and a %v/%s in a log line — including
+// one emitted from inside a held limiter slot — must never trigger that
+// re-entry. Dumping a message is always an explicit Dump() call.
+
+// dumpValue renders one property value, truncating anything longer than
+// dumpMaxValueLen to keep the dump bounded.
+func dumpValue(value any) string {
+	rendered := fmt.Sprintf("%v", value)
+	if s, ok := value.(string); ok {
+		rendered = fmt.Sprintf("%q", s)
+	}
+
+	if len(rendered) > dumpMaxValueLen {
+		return fmt.Sprintf(
+			"%s... (%d bytes)",
+			rendered[:dumpMaxValueLen],
+			len(rendered),
+		)
+	}
+
+	return rendered
+}
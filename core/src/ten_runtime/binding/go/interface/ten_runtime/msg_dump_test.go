@@ -0,0 +1,35 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDumpValueTruncates(t *testing.T) {
+	long := strings.Repeat("a", 1024)
+
+	rendered := dumpValue(long)
+	if len(rendered) >= 1024 {
+		t.FailNow()
+	}
+
+	if !strings.Contains(rendered, "...") ||
+		!strings.Contains(rendered, "bytes") {
+		t.FailNow()
+	}
+
+	if dumpValue("short") != `"short"` {
+		t.FailNow()
+	}
+
+	if dumpValue(true) != "true" {
+		t.FailNow()
+	}
+}
@@ -0,0 +1,139 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// NewCmdFromMap builds a fully-populated cmd from a Go map in one step, the
+// write-side complement of ToMap. Nested maps become object properties and
+// slices become arrays; see validatePropValue for the supported value types.
+// An unsupported value is rejected with the offending key path before
+// anything is set.
+func NewCmdFromMap(name string, props map[string]any) (Cmd, error) {
+	cmd, err := NewCmd(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := applyPropMap(cmd, props); err != nil {
+		return nil, err
+	}
+
+	return cmd, nil
+}
+
+// NewDataFromMap builds a fully-populated data message from a Go map in one
+// step; see NewCmdFromMap.
+func NewDataFromMap(name string, props map[string]any) (Data, error) {
+	data, err := NewData(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := applyPropMap(data, props); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// applyPropMap validates the whole map first and then sets each top-level
+// entry as a property, so a bad value leaves the message untouched.
+func applyPropMap(target iProperty, props map[string]any) error {
+	for key, value := range props {
+		if len(key) == 0 {
+			return NewTenError(
+				ErrorCodeInvalidArgument,
+				"an empty property key is not allowed.",
+			)
+		}
+
+		if err := validatePropValue(key, value, true); err != nil {
+			return err
+		}
+	}
+
+	for key, value := range props {
+		if b, ok := value.([]byte); ok {
+			if err := target.SetProperty(key, b); err != nil {
+				return err
+			}
+			continue
+		}
+
+		jsonBytes, err := json.Marshal(value)
+		if err != nil {
+			return NewTenError(
+				ErrorCodeInvalidJSON,
+				fmt.Sprintf("key %q: %s", key, err.Error()),
+			)
+		}
+
+		if err := target.SetPropertyFromJSONBytes(key, jsonBytes); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validatePropValue checks one value of the map, recursing into nested maps
+// and slices. Supported: nil, bool, string, json.Number, the built-in
+// integer and float types, []byte (top level only, as JSON has no raw-bytes
+// representation for nested values), []any and map[string]any.
+func validatePropValue(path string, value any, topLevel bool) error {
+	switch v := value.(type) {
+	case nil, bool, string, json.Number,
+		int, int8, int16, int32, int64,
+		uint, uint8, uint16, uint32, uint64,
+		float32, float64:
+		return nil
+
+	case []byte:
+		if topLevel {
+			return nil
+		}
+		return NewTenError(
+			ErrorCodeInvalidType,
+			fmt.Sprintf(
+				"[]byte is only supported as a top-level property, key path: %q.",
+				path,
+			),
+		)
+
+	case []any:
+		for i, elem := range v {
+			elemPath := fmt.Sprintf("%s[%d]", path, i)
+			if err := validatePropValue(elemPath, elem, false); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case map[string]any:
+		for key, elem := range v {
+			if err := validatePropValue(path+"."+key, elem, false); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	default:
+		return NewTenError(
+			ErrorCodeInvalidType,
+			fmt.Sprintf(
+				"unsupported value type %T, key path: %q.",
+				value,
+				path,
+			),
+		)
+	}
+}
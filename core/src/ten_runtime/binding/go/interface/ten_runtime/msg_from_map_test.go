@@ -0,0 +1,64 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestValidatePropValueSupportedTypes(t *testing.T) {
+	props := map[string]any{
+		"nil":    nil,
+		"bool":   true,
+		"string": "v",
+		"int":    42,
+		"float":  3.14,
+		"bytes":  []byte{1, 2, 3},
+		"array":  []any{"a", 1, map[string]any{"k": "v"}},
+		"object": map[string]any{"nested": map[string]any{"deep": 1}},
+	}
+
+	for key, value := range props {
+		if err := validatePropValue(key, value, true); err != nil {
+			t.FailNow()
+		}
+	}
+}
+
+func TestValidatePropValueRejectsWithPath(t *testing.T) {
+	// A channel is not representable; the error must name where it sits.
+	err := validatePropValue(
+		"outer",
+		map[string]any{"inner": make(chan int)},
+		true,
+	)
+	if err == nil {
+		t.FailNow()
+	}
+
+	var tenErr *TenError
+	if !errors.As(err, &tenErr) {
+		t.FailNow()
+	}
+	if !strings.Contains(tenErr.ErrorMessage, `"outer.inner"`) {
+		t.FailNow()
+	}
+}
+
+func TestValidatePropValueRejectsNestedBytes(t *testing.T) {
+	err := validatePropValue(
+		"outer",
+		map[string]any{"buf": []byte{1}},
+		true,
+	)
+	if err == nil {
+		t.FailNow()
+	}
+}
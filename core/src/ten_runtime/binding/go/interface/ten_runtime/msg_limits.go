@@ -0,0 +1,114 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync/atomic"
+)
+
+// ErrMessageTooLarge is returned (wrapped with detail) when a send exceeds
+// the configured message limits; test with errors.Is.
+var ErrMessageTooLarge = errors.New(
+	"message exceeds the configured limits",
+)
+
+const (
+	// DefaultMaxMessageSizeBytes is the recommended cap on the serialized
+	// property tree of a message, for apps that opt in via SetMaxMessageSize.
+	// Generous enough for any sane payload, small enough to stop a runaway
+	// one before it destabilizes the runtime.
+	DefaultMaxMessageSizeBytes int64 = 64 << 20 // 64 MiB
+
+	// DefaultMaxPropertyCount is the recommended cap on the number of
+	// top-level properties of a message, for apps that opt in via
+	// SetMaxPropertyCount.
+	DefaultMaxPropertyCount int64 = 1024
+)
+
+// The limits start disabled: enforcing them costs a full property-tree
+// serialization per send — a cgo round trip and an allocation on the hottest
+// path, including every media frame — which only apps that asked for the
+// protection should pay.
+var (
+	maxMessageSizeBytes atomic.Int64
+	maxPropertyCount    atomic.Int64
+)
+
+// SetMaxMessageSize configures the app-wide cap on a message's serialized
+// property tree, enforced at send time; 0 (the default) disables the check.
+// Frame buffers (data/audio/video payloads) are not counted, only
+// properties. Enforcement serializes the property tree on every send, so
+// enable it deliberately; DefaultMaxMessageSizeBytes is the recommended cap.
+func SetMaxMessageSize(bytes int64) {
+	if bytes < 0 {
+		bytes = 0
+	}
+	maxMessageSizeBytes.Store(bytes)
+}
+
+// SetMaxPropertyCount configures the app-wide cap on the number of
+// top-level properties of a message, enforced at send time; 0 (the default)
+// disables the check.
+func SetMaxPropertyCount(count int64) {
+	if count < 0 {
+		count = 0
+	}
+	maxPropertyCount.Store(count)
+}
+
+// checkMessageLimits enforces the configured limits on a message about to be
+// sent, returning an error wrapping ErrMessageTooLarge when exceeded.
+func checkMessageLimits(m any) error {
+	sizeLimit := maxMessageSizeBytes.Load()
+	countLimit := maxPropertyCount.Load()
+	if sizeLimit == 0 && countLimit == 0 {
+		return nil
+	}
+
+	subject, ok := m.(interface {
+		GetPropertyToJSONBytes(path string) ([]byte, error)
+	})
+	if !ok {
+		return nil
+	}
+
+	propertyJSON, err := subject.GetPropertyToJSONBytes("")
+	if err != nil {
+		// A message without a property tree has nothing to limit.
+		return nil
+	}
+	defer ReleaseBytes(propertyJSON)
+
+	if sizeLimit > 0 && int64(len(propertyJSON)) > sizeLimit {
+		return fmt.Errorf(
+			"%w: properties serialize to %d bytes, limit is %d",
+			ErrMessageTooLarge,
+			len(propertyJSON),
+			sizeLimit,
+		)
+	}
+
+	if countLimit > 0 {
+		var top map[string]json.RawMessage
+		if err := json.Unmarshal(propertyJSON, &top); err == nil {
+			if int64(len(top)) > countLimit {
+				return fmt.Errorf(
+					"%w: %d top-level properties, limit is %d",
+					ErrMessageTooLarge,
+					len(top),
+					countLimit,
+				)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"errors"
+	"testing"
+)
+
+// fakeLimitedMsg stands in for a message whose property tree serializes to a
+// fixed JSON document.
+type fakeLimitedMsg struct {
+	propertyJSON string
+}
+
+func (f *fakeLimitedMsg) GetPropertyToJSONBytes(path string) ([]byte, error) {
+	return []byte(f.propertyJSON), nil
+}
+
+func resetMessageLimits() {
+	SetMaxMessageSize(0)
+	SetMaxPropertyCount(0)
+}
+
+func TestMessageLimitsOffByDefault(t *testing.T) {
+	m := &fakeLimitedMsg{propertyJSON: `{"a":1,"b":2,"c":3}`}
+	if err := checkMessageLimits(m); err != nil {
+		t.FailNow()
+	}
+}
+
+func TestMessageLimitsRecommendedCapsAllowSmallMsg(t *testing.T) {
+	defer resetMessageLimits()
+
+	SetMaxMessageSize(DefaultMaxMessageSizeBytes)
+	SetMaxPropertyCount(DefaultMaxPropertyCount)
+
+	m := &fakeLimitedMsg{propertyJSON: `{"k":"v"}`}
+	if err := checkMessageLimits(m); err != nil {
+		t.FailNow()
+	}
+}
+
+func TestMessageSizeLimitExceeded(t *testing.T) {
+	defer resetMessageLimits()
+
+	SetMaxMessageSize(4)
+	m := &fakeLimitedMsg{propertyJSON: `{"k":"a long value"}`}
+
+	err := checkMessageLimits(m)
+	if !errors.Is(err, ErrMessageTooLarge) {
+		t.FailNow()
+	}
+}
+
+func TestPropertyCountLimitExceeded(t *testing.T) {
+	defer resetMessageLimits()
+
+	SetMaxPropertyCount(2)
+	m := &fakeLimitedMsg{propertyJSON: `{"a":1,"b":2,"c":3}`}
+
+	err := checkMessageLimits(m)
+	if !errors.Is(err, ErrMessageTooLarge) {
+		t.FailNow()
+	}
+}
+
+func TestMessageLimitsDisabled(t *testing.T) {
+	defer resetMessageLimits()
+
+	SetMaxMessageSize(0)
+	SetMaxPropertyCount(0)
+
+	m := &fakeLimitedMsg{propertyJSON: `{"a":1,"b":2,"c":3}`}
+	if err := checkMessageLimits(m); err != nil {
+		t.FailNow()
+	}
+}
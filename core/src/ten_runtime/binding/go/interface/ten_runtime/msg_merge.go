@@ -0,0 +1,65 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import "encoding/json"
+
+// mergePropertyTrees deep-merges src over dst and returns dst. Nested objects
+// are merged key by key; arrays and scalars from src replace the dst value
+// wholesale.
+func mergePropertyTrees(
+	dst map[string]any,
+	src map[string]any,
+) map[string]any {
+	for key, srcValue := range src {
+		if srcObj, ok := srcValue.(map[string]any); ok {
+			if dstObj, ok := dst[key].(map[string]any); ok {
+				dst[key] = mergePropertyTrees(dstObj, srcObj)
+				continue
+			}
+		}
+
+		dst[key] = srcValue
+	}
+
+	return dst
+}
+
+// MergeProperties deep-merges the given properties over the existing property
+// tree of the message. Nested objects are merged recursively, so unrelated
+// fields survive; arrays and scalars are replaced, not concatenated. Compare
+// SetProperty, which overwrites one path at a time.
+func (p *msg) MergeProperties(properties map[string]any) error {
+	if len(properties) == 0 {
+		return nil
+	}
+
+	existingJSON, err := p.GetPropertyToJSONBytes("")
+	if err != nil {
+		return err
+	}
+	defer ReleaseBytes(existingJSON)
+
+	var existing map[string]any
+	if err := json.Unmarshal(existingJSON, &existing); err != nil {
+		return NewTenError(
+			ErrorCodeInvalidJSON,
+			err.Error(),
+		)
+	}
+
+	merged, err := json.Marshal(mergePropertyTrees(existing, properties))
+	if err != nil {
+		return NewTenError(
+			ErrorCodeInvalidJSON,
+			err.Error(),
+		)
+	}
+
+	return p.SetPropertyFromJSONBytes("", merged)
+}
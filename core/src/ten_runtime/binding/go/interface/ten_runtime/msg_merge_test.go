@@ -0,0 +1,51 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMergePropertyTrees(t *testing.T) {
+	dst := map[string]any{
+		"keep": "old",
+		"nested": map[string]any{
+			"a": 1,
+			"b": 2,
+		},
+		"arr": []any{1, 2, 3},
+	}
+
+	src := map[string]any{
+		"nested": map[string]any{
+			"b": 20,
+			"c": 30,
+		},
+		"arr": []any{9},
+		"new": true,
+	}
+
+	got := mergePropertyTrees(dst, src)
+
+	want := map[string]any{
+		"keep": "old",
+		"nested": map[string]any{
+			"a": 1,
+			"b": 20,
+			"c": 30,
+		},
+		// Arrays are replaced wholesale, not concatenated.
+		"arr": []any{9},
+		"new": true,
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.FailNow()
+	}
+}
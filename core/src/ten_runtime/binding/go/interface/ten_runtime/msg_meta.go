@@ -0,0 +1,61 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import "strings"
+
+// MetaPropertyPrefix is the reserved property subtree backing message
+// metadata. User properties must not use this prefix; SetMeta/GetMeta are
+// the supported accessors.
+const MetaPropertyPrefix = "ten:meta."
+
+// SetMeta attaches transport metadata (trace IDs, routing hints) to the
+// message, in a namespace separate from the application property tree, so
+// framework concerns can never collide with a user field of the same name.
+//
+// Metadata is carried on the message itself: it travels with the message to
+// its destinations and survives Clone, which is what trace IDs need.
+// It does not propagate automatically onto results or onto new messages the
+// receiver creates; forwarding extensions must copy it explicitly.
+func (p *msg) SetMeta(key string, value string) error {
+	if err := validateMetaKey(key); err != nil {
+		return err
+	}
+
+	return p.SetPropertyString(MetaPropertyPrefix+key, value)
+}
+
+// GetMeta reads metadata attached with SetMeta; it fails for a key that was
+// never set.
+func (p *msg) GetMeta(key string) (string, error) {
+	if err := validateMetaKey(key); err != nil {
+		return "", err
+	}
+
+	return p.GetPropertyString(MetaPropertyPrefix + key)
+}
+
+func validateMetaKey(key string) error {
+	if len(key) == 0 {
+		return NewTenError(
+			ErrorCodeInvalidArgument,
+			"the metadata key is required.",
+		)
+	}
+
+	// A dot would address a nested path inside the metadata subtree; keys
+	// are flat by design.
+	if strings.Contains(key, ".") {
+		return NewTenError(
+			ErrorCodeInvalidArgument,
+			"the metadata key must not contain dots.",
+		)
+	}
+
+	return nil
+}
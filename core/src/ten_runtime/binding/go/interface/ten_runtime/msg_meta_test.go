@@ -0,0 +1,25 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import "testing"
+
+func TestValidateMetaKey(t *testing.T) {
+	if err := validateMetaKey("trace_id"); err != nil {
+		t.FailNow()
+	}
+
+	if err := validateMetaKey(""); err == nil {
+		t.FailNow()
+	}
+
+	// Dots would address nested paths; metadata keys are flat.
+	if err := validateMetaKey("trace.id"); err == nil {
+		t.FailNow()
+	}
+}
@@ -0,0 +1,53 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+// The Must variants below wrap the message constructors and panic on error,
+// for tests and examples where an invalid name is a programming mistake and
+// `cmd, _ := NewCmd(...)` would silently hide it. Production code should
+// keep using the error-returning constructors.
+
+// MustNewCmd is NewCmd but panics on error, for tests.
+func MustNewCmd(cmdName string) Cmd {
+	c, err := NewCmd(cmdName)
+	if err != nil {
+		panic(err)
+	}
+
+	return c
+}
+
+// MustNewData is NewData but panics on error, for tests.
+func MustNewData(dataName string) Data {
+	d, err := NewData(dataName)
+	if err != nil {
+		panic(err)
+	}
+
+	return d
+}
+
+// MustNewVideoFrame is NewVideoFrame but panics on error, for tests.
+func MustNewVideoFrame(videoFrameName string) VideoFrame {
+	f, err := NewVideoFrame(videoFrameName)
+	if err != nil {
+		panic(err)
+	}
+
+	return f
+}
+
+// MustNewAudioFrame is NewAudioFrame but panics on error, for tests.
+func MustNewAudioFrame(audioFrameName string) AudioFrame {
+	f, err := NewAudioFrame(audioFrameName)
+	if err != nil {
+		panic(err)
+	}
+
+	return f
+}
@@ -0,0 +1,47 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMustNewCmdPanicsOnEmptyName(t *testing.T) {
+	defer func() {
+		recovered := recover()
+		if recovered == nil {
+			t.FailNow()
+		}
+
+		err, ok := recovered.(error)
+		if !ok {
+			t.FailNow()
+		}
+
+		var tenErr *TenError
+		if !errors.As(err, &tenErr) {
+			t.FailNow()
+		}
+		if tenErr.ErrorCode != ErrorCodeInvalidArgument {
+			t.FailNow()
+		}
+	}()
+
+	MustNewCmd("")
+}
+
+func TestMustNewDataPanicsOnEmptyName(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.FailNow()
+		}
+	}()
+
+	MustNewData("")
+}
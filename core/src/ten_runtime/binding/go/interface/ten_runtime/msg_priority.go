@@ -0,0 +1,69 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+// PriorityPropertyName is the property carrying the message priority level.
+const PriorityPropertyName = "ten:priority"
+
+// MessagePriority is the priority level of a message. The set is deliberately
+// small; fine-grained priorities invite starvation bugs without buying much.
+type MessagePriority int64
+
+const (
+	// PriorityLow marks bulk traffic that can yield to everything else.
+	PriorityLow MessagePriority = -1
+
+	// PriorityNormal is the implicit priority of every message that never
+	// had SetPriority called on it.
+	PriorityNormal MessagePriority = 0
+
+	// PriorityHigh marks control messages (stop, reconfigure, close_app)
+	// that should jump ahead of bulk data.
+	PriorityHigh MessagePriority = 1
+)
+
+// SetPriority attaches a priority level to the message.
+//
+// Best-effort: the runtime's message queues are FIFO today and do not reorder
+// by priority, so the level is delivered as metadata rather than enforced in
+// transit. Receiving extensions that maintain their own backlog (and any
+// future runtime queue support) should consult GetPriority and serve
+// PriorityHigh first, so a close_app does not wait behind thousands of
+// flooded messages.
+func (p *msg) SetPriority(level MessagePriority) error {
+	switch level {
+	case PriorityLow, PriorityNormal, PriorityHigh:
+	default:
+		return NewTenError(
+			ErrorCodeInvalidArgument,
+			"unknown priority level.",
+		)
+	}
+
+	return p.SetProperty(PriorityPropertyName, int64(level))
+}
+
+// GetPriority returns the priority level attached to the message;
+// PriorityNormal for a message that never had one set.
+func (p *msg) GetPriority() (MessagePriority, error) {
+	level, err := p.GetPropertyInt64(PriorityPropertyName)
+	if err != nil {
+		// The property is simply absent on most messages.
+		return PriorityNormal, nil
+	}
+
+	switch MessagePriority(level) {
+	case PriorityLow, PriorityNormal, PriorityHigh:
+		return MessagePriority(level), nil
+	default:
+		return PriorityNormal, NewTenError(
+			ErrorCodeInvalidArgument,
+			"the message carries an unknown priority level.",
+		)
+	}
+}
@@ -772,3 +772,24 @@ func (p *msg) GetPropertyToJSONBytes(path string) ([]byte, error) {
 		return p.getPropertyToJSONBytes(path)
 	})
 }
+
+func (p *msg) GetPropertyType(path string) (ValueType, error) {
+	if len(path) == 0 {
+		return valueTypeInvalid, NewTenError(
+			ErrorCodeInvalidArgument,
+			"property path is required.",
+		)
+	}
+
+	defer p.keepAlive()
+
+	return withCGOLimiterHasReturnValue[ValueType](func() (ValueType, error) {
+		var pSize propSizeInC = 0
+		pt, err := p.getPropertyTypeAndSize(path, &pSize)
+		if err != nil {
+			return valueTypeInvalid, err
+		}
+
+		return pt.toValueType(), nil
+	})
+}
@@ -0,0 +1,53 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// RangeProperties iterates all top-level properties of the message, calling
+// fn for each key/value pair until fn returns false. Values are decoded json
+// values: string, bool, json.Number, []any or map[string]any. The iteration
+// order is unspecified.
+func (p *msg) RangeProperties(fn func(key string, value any) bool) error {
+	if fn == nil {
+		return NewTenError(
+			ErrorCodeInvalidArgument,
+			"fn is required.",
+		)
+	}
+
+	propertyJSON, err := p.GetPropertyToJSONBytes("")
+	if err != nil {
+		return err
+	}
+	defer ReleaseBytes(propertyJSON)
+
+	// Decode with UseNumber so integer properties survive the round trip
+	// without losing precision to float64.
+	decoder := json.NewDecoder(bytes.NewReader(propertyJSON))
+	decoder.UseNumber()
+
+	var props map[string]any
+	if err := decoder.Decode(&props); err != nil {
+		return NewTenError(
+			ErrorCodeInvalidJSON,
+			err.Error(),
+		)
+	}
+
+	for key, value := range props {
+		if !fn(key, value) {
+			break
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,179 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// The schema validation below supports the keyword subset used by the TEN
+// schema system: "type", "properties", "required" and "items". The module has
+// no third-party dependencies on purpose, so a full JSON-schema implementation
+// is out of scope here.
+
+// ValidateAgainstSchema validates the properties of the msg against the given
+// schema. Unlike a plain error return, all violations are collected and
+// reported in one error, so the caller sees every invalid field at once. A nil
+// return means the properties conform to the schema.
+func (p *msg) ValidateAgainstSchema(schemaJSON []byte) error {
+	if len(schemaJSON) == 0 {
+		return NewTenError(
+			ErrorCodeInvalidArgument,
+			"the schema is required",
+		)
+	}
+
+	var schema map[string]any
+	if err := json.Unmarshal(schemaJSON, &schema); err != nil {
+		return NewTenError(ErrorCodeInvalidJSON, err.Error())
+	}
+
+	propertyJSON, err := p.GetPropertyToJSONBytes("")
+	if err != nil {
+		return err
+	}
+	defer ReleaseBytes(propertyJSON)
+
+	var properties any
+	if err := json.Unmarshal(propertyJSON, &properties); err != nil {
+		return NewTenError(ErrorCodeInvalidJSON, err.Error())
+	}
+
+	var violations []string
+	validateValueAgainstSchema(properties, schema, "", &violations)
+
+	if len(violations) > 0 {
+		return NewTenError(
+			ErrorCodeGeneric,
+			strings.Join(violations, "; "),
+		)
+	}
+
+	return nil
+}
+
+// schemaTypeMatches checks a json-decoded value against a TEN schema type
+// name. Numbers decoded from json are float64, so the integer types accept
+// integral floats.
+func schemaTypeMatches(value any, schemaType string) bool {
+	switch schemaType {
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "bool":
+		_, ok := value.(bool)
+		return ok
+	case "object":
+		_, ok := value.(map[string]any)
+		return ok
+	case "array":
+		_, ok := value.([]any)
+		return ok
+	case "null":
+		return value == nil
+	case "int8", "int16", "int32", "int64",
+		"uint8", "uint16", "uint32", "uint64":
+		number, ok := value.(float64)
+		return ok && number == float64(int64(number))
+	case "float32", "float64":
+		_, ok := value.(float64)
+		return ok
+	default:
+		return false
+	}
+}
+
+func validateValueAgainstSchema(
+	value any,
+	schema map[string]any,
+	path string,
+	violations *[]string,
+) {
+	displayPath := path
+	if displayPath == "" {
+		displayPath = "(root)"
+	}
+
+	if schemaType, ok := schema["type"].(string); ok {
+		if !schemaTypeMatches(value, schemaType) {
+			*violations = append(
+				*violations,
+				fmt.Sprintf(
+					"%s: expected type %s, actual: %T",
+					displayPath,
+					schemaType,
+					value,
+				),
+			)
+			return
+		}
+	}
+
+	if obj, ok := value.(map[string]any); ok {
+		if required, ok := schema["required"].([]any); ok {
+			for _, field := range required {
+				fieldName, ok := field.(string)
+				if !ok {
+					continue
+				}
+
+				if _, exists := obj[fieldName]; !exists {
+					*violations = append(
+						*violations,
+						fmt.Sprintf(
+							"%s: missing required property %q",
+							displayPath,
+							fieldName,
+						),
+					)
+				}
+			}
+		}
+
+		if properties, ok := schema["properties"].(map[string]any); ok {
+			for fieldName, fieldSchema := range properties {
+				fieldSchemaObj, ok := fieldSchema.(map[string]any)
+				if !ok {
+					continue
+				}
+
+				fieldValue, exists := obj[fieldName]
+				if !exists {
+					continue
+				}
+
+				fieldPath := fieldName
+				if path != "" {
+					fieldPath = path + "." + fieldName
+				}
+
+				validateValueAgainstSchema(
+					fieldValue,
+					fieldSchemaObj,
+					fieldPath,
+					violations,
+				)
+			}
+		}
+	}
+
+	if arr, ok := value.([]any); ok {
+		if items, ok := schema["items"].(map[string]any); ok {
+			for i, element := range arr {
+				validateValueAgainstSchema(
+					element,
+					items,
+					fmt.Sprintf("%s[%d]", path, i),
+					violations,
+				)
+			}
+		}
+	}
+}
@@ -0,0 +1,74 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestValidateValueAgainstSchema(t *testing.T) {
+	var schema map[string]any
+	err := json.Unmarshal([]byte(`{
+		"type": "object",
+		"required": ["greetingMsg"],
+		"properties": {
+			"greetingMsg": {"type": "string"},
+			"count": {"type": "int32"}
+		}
+	}`), &schema)
+	if err != nil {
+		t.FailNow()
+	}
+
+	var value any
+	err = json.Unmarshal([]byte(`{"count": "three"}`), &value)
+	if err != nil {
+		t.FailNow()
+	}
+
+	var violations []string
+	validateValueAgainstSchema(value, schema, "", &violations)
+
+	// Both the missing required property and the type mismatch must be
+	// reported.
+	if len(violations) != 2 {
+		t.FailNow()
+	}
+
+	joined := strings.Join(violations, "; ")
+	if !strings.Contains(joined, "greetingMsg") ||
+		!strings.Contains(joined, "count") {
+		t.FailNow()
+	}
+}
+
+func TestValidateValueAgainstSchemaItems(t *testing.T) {
+	var schema map[string]any
+	err := json.Unmarshal([]byte(`{
+		"type": "array",
+		"items": {"type": "int64"}
+	}`), &schema)
+	if err != nil {
+		t.FailNow()
+	}
+
+	var value any
+	err = json.Unmarshal([]byte(`[1, 2.5, 3]`), &value)
+	if err != nil {
+		t.FailNow()
+	}
+
+	var violations []string
+	validateValueAgainstSchema(value, schema, "", &violations)
+
+	if len(violations) != 1 || !strings.Contains(violations[0], "[1]") {
+		t.FailNow()
+	}
+}
@@ -0,0 +1,38 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+// SetPropertyIfAbsent sets a property only when the path holds no value yet,
+// and reports whether it was set. It is the defaulting primitive for code
+// that enriches forwarded messages (ex: outbound middleware injecting
+// defaults): an existing value always wins.
+//
+// A message is owned by a single extension at a time, so the probe-then-set
+// pair does not race; there is no window for another goroutine to legally
+// mutate the same message in between.
+func (p *msg) SetPropertyIfAbsent(path string, value any) (bool, error) {
+	if len(path) == 0 {
+		return false, NewTenError(
+			ErrorCodeInvalidArgument,
+			"property path is required.",
+		)
+	}
+
+	defer p.keepAlive()
+
+	if _, err := p.GetPropertyType(path); err == nil {
+		// The path already holds a value; keep it.
+		return false, nil
+	}
+
+	if err := p.SetProperty(path, value); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
@@ -0,0 +1,44 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// ToMap returns the message's entire property tree as a native Go map, for
+// debugging and generic transforms that would otherwise need per-field
+// getters. It is the read-side complement of SetProperties.
+//
+// The value mapping follows the JSON form of the tree: objects become
+// map[string]any, arrays become []any, strings and bools map to themselves,
+// and numbers are json.Number so integers survive without losing precision
+// to float64. A null property becomes nil; []byte and pointer properties
+// also serialize to null today, so they appear as nil — fetch those with
+// GetPropertyBytes / GetPropertyPtr instead.
+func (p *msg) ToMap() (map[string]any, error) {
+	propertyJSON, err := p.GetPropertyToJSONBytes("")
+	if err != nil {
+		return nil, err
+	}
+	defer ReleaseBytes(propertyJSON)
+
+	decoder := json.NewDecoder(bytes.NewReader(propertyJSON))
+	decoder.UseNumber()
+
+	var props map[string]any
+	if err := decoder.Decode(&props); err != nil {
+		return nil, NewTenError(
+			ErrorCodeInvalidJSON,
+			err.Error(),
+		)
+	}
+
+	return props, nil
+}
@@ -244,8 +244,17 @@ type iProperty interface {
 
 	GetPropertyBool(path string) (bool, error)
 
+	// GetPropertyPtr returns a Go value stored with SetPropertyPtr (or by
+	// SetProperty's automatic pointer inference). The value is only valid
+	// while the owning message or ten env property exists; see the ownership
+	// rules in prop_ptr.go.
 	GetPropertyPtr(path string) (any, error)
 
+	// SetPropertyPtr stores an arbitrary Go value as an opaque pointer
+	// property, pinned against garbage collection for the lifetime of the
+	// property. See the ownership rules in prop_ptr.go.
+	SetPropertyPtr(path string, value any) error
+
 	// GetPropertyString gets a string property by the given path. The reason we
 	// define a concrete method for string is that the returned type of
 	// GetProperty is `any`, there is a type conversion in returning an `any`
@@ -264,6 +273,26 @@ type iProperty interface {
 	// runtime. If the property exists, the json data will be marshalled to a
 	// json bytes.
 	GetPropertyToJSONBytes(path string) ([]byte, error)
+
+	// GetPropertyType returns the type of the property at the given path, so
+	// a generic consumer can call the matching typed getter directly instead
+	// of probing getters and catching type errors.
+	GetPropertyType(path string) (ValueType, error)
+
+	// Prop returns a panic-free accessor for the property at the given path
+	// that falls back to a default on missing or wrong-type values. See
+	// PropAccessor.
+	Prop(path string) PropAccessor
+
+	// GetPropertyEnum reads a string property that must be one of a fixed
+	// set of values. A value outside the set yields an error listing the
+	// allowed values; EnumCaseInsensitive relaxes the match and returns the
+	// canonical spelling from the allowed set.
+	GetPropertyEnum(
+		path string,
+		allowed []string,
+		opts ...EnumOption,
+	) (string, error)
 }
 
 // The purpose of having this function is because there are two types of
@@ -494,5 +523,17 @@ func getPropPtr(
 		return nil, err
 	}
 
-	return loadGoHandle(goHandle(cv)), nil
+	v := loadGoHandle(goHandle(cv))
+	if v == nil {
+		// The property holds a handle that is not pinned in this process.
+		// Either the message crossed a process boundary, or the value has
+		// already been unpinned; in both cases the pointer is unusable.
+		return nil, NewTenError(
+			ErrorCodeGeneric,
+			"the pointer property is not pinned in this process; pointer "+
+				"properties are only valid within the process that set them.",
+		)
+	}
+
+	return v, nil
 }
@@ -0,0 +1,89 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+// PropAccessor wraps a single property path for panic-free reads with
+// defaults, so deeply optional config does not need a chain of typed getters
+// and error checks:
+//
+//	name := tenEnv.Prop("a.b.c").StringOr("default")
+//
+// A missing path or a wrong-type value yields the default; no accessor method
+// panics. It is pure ergonomics over the existing typed getters.
+type PropAccessor struct {
+	owner iProperty
+	path  string
+}
+
+// StringOr returns the property as a string, or def when it is missing or
+// not a string.
+func (a PropAccessor) StringOr(def string) string {
+	if a.owner == nil {
+		return def
+	}
+
+	value, err := a.owner.GetPropertyString(a.path)
+	if err != nil {
+		return def
+	}
+	return value
+}
+
+// IntOr returns the property as an int64, or def when it is missing or not
+// an integer.
+func (a PropAccessor) IntOr(def int64) int64 {
+	if a.owner == nil {
+		return def
+	}
+
+	value, err := a.owner.GetPropertyInt64(a.path)
+	if err != nil {
+		return def
+	}
+	return value
+}
+
+// BoolOr returns the property as a bool, or def when it is missing or not a
+// bool.
+func (a PropAccessor) BoolOr(def bool) bool {
+	if a.owner == nil {
+		return def
+	}
+
+	value, err := a.owner.GetPropertyBool(a.path)
+	if err != nil {
+		return def
+	}
+	return value
+}
+
+// Float64Or returns the property as a float64, or def when it is missing or
+// not a float.
+func (a PropAccessor) Float64Or(def float64) float64 {
+	if a.owner == nil {
+		return def
+	}
+
+	value, err := a.owner.GetPropertyFloat64(a.path)
+	if err != nil {
+		return def
+	}
+	return value
+}
+
+func (p *tenEnv) Prop(path string) PropAccessor {
+	return PropAccessor{owner: p, path: path}
+}
+
+func (p *msg) Prop(path string) PropAccessor {
+	return PropAccessor{owner: p, path: path}
+}
+
+func (p *appTesterTenEnv) Prop(path string) PropAccessor {
+	return PropAccessor{owner: p, path: path}
+}
@@ -0,0 +1,60 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"testing"
+)
+
+func TestPropAccessorDefaults(t *testing.T) {
+	env := newAppTesterTenEnv()
+
+	if env.Prop("missing").StringOr("fallback") != "fallback" {
+		t.FailNow()
+	}
+	if env.Prop("missing").IntOr(42) != 42 {
+		t.FailNow()
+	}
+	if env.Prop("missing").BoolOr(true) != true {
+		t.FailNow()
+	}
+	if env.Prop("missing").Float64Or(1.5) != 1.5 {
+		t.FailNow()
+	}
+}
+
+func TestPropAccessorReadsValues(t *testing.T) {
+	env := newAppTesterTenEnv()
+
+	if err := env.SetProperty("name", "agent"); err != nil {
+		t.FailNow()
+	}
+	if err := env.SetProperty("enabled", true); err != nil {
+		t.FailNow()
+	}
+
+	if env.Prop("name").StringOr("fallback") != "agent" {
+		t.FailNow()
+	}
+	if env.Prop("enabled").BoolOr(false) != true {
+		t.FailNow()
+	}
+}
+
+func TestPropAccessorWrongTypeFallsBack(t *testing.T) {
+	env := newAppTesterTenEnv()
+
+	if err := env.SetProperty("name", "agent"); err != nil {
+		t.FailNow()
+	}
+
+	// A string property read as an int must yield the default, not panic.
+	if env.Prop("name").IntOr(7) != 7 {
+		t.FailNow()
+	}
+}
@@ -0,0 +1,100 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"fmt"
+	"strings"
+)
+
+// enumOptions collects the EnumOption knobs for GetPropertyEnum.
+type enumOptions struct {
+	caseInsensitive bool
+}
+
+// EnumOption adjusts how GetPropertyEnum matches the property against the
+// allowed set.
+type EnumOption func(*enumOptions)
+
+// EnumCaseInsensitive makes the membership check ignore case. The returned
+// value is the canonical spelling from the allowed set, not the property's
+// spelling, so callers can switch on it directly.
+func EnumCaseInsensitive() EnumOption {
+	return func(o *enumOptions) {
+		o.caseInsensitive = true
+	}
+}
+
+// getPropEnum is the shared implementation behind the msg and ten env
+// GetPropertyEnum methods.
+func getPropEnum(
+	owner iProperty,
+	path string,
+	allowed []string,
+	opts ...EnumOption,
+) (string, error) {
+	if len(allowed) == 0 {
+		return "", NewTenError(
+			ErrorCodeInvalidArgument,
+			"the allowed set is empty.",
+		)
+	}
+
+	var options enumOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	value, err := owner.GetPropertyString(path)
+	if err != nil {
+		return "", err
+	}
+
+	for _, candidate := range allowed {
+		if candidate == value {
+			return candidate, nil
+		}
+		if options.caseInsensitive && strings.EqualFold(candidate, value) {
+			return candidate, nil
+		}
+	}
+
+	return "", NewTenError(
+		ErrorCodeInvalidArgument,
+		fmt.Sprintf(
+			"the property %q is %q, but must be one of: %s.",
+			path,
+			value,
+			strings.Join(allowed, ", "),
+		),
+	)
+}
+
+func (p *tenEnv) GetPropertyEnum(
+	path string,
+	allowed []string,
+	opts ...EnumOption,
+) (string, error) {
+	return getPropEnum(p, path, allowed, opts...)
+}
+
+func (p *msg) GetPropertyEnum(
+	path string,
+	allowed []string,
+	opts ...EnumOption,
+) (string, error) {
+	return getPropEnum(p, path, allowed, opts...)
+}
+
+func (p *appTesterTenEnv) GetPropertyEnum(
+	path string,
+	allowed []string,
+	opts ...EnumOption,
+) (string, error) {
+	return getPropEnum(p, path, allowed, opts...)
+}
@@ -0,0 +1,77 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestGetPropertyEnumMatches(t *testing.T) {
+	env := newAppTesterTenEnv()
+	if err := env.SetPropertyString("mode", "fast"); err != nil {
+		t.FailNow()
+	}
+
+	value, err := env.GetPropertyEnum("mode", []string{"fast", "accurate"})
+	if err != nil || value != "fast" {
+		t.FailNow()
+	}
+}
+
+func TestGetPropertyEnumRejectsWithAllowedList(t *testing.T) {
+	env := newAppTesterTenEnv()
+	if err := env.SetPropertyString("mode", "turbo"); err != nil {
+		t.FailNow()
+	}
+
+	_, err := env.GetPropertyEnum("mode", []string{"fast", "accurate"})
+	if err == nil {
+		t.FailNow()
+	}
+
+	var tenErr *TenError
+	if !errors.As(err, &tenErr) {
+		t.FailNow()
+	}
+	if !strings.Contains(tenErr.ErrorMessage, "fast, accurate") {
+		t.FailNow()
+	}
+}
+
+func TestGetPropertyEnumCaseInsensitive(t *testing.T) {
+	env := newAppTesterTenEnv()
+	if err := env.SetPropertyString("mode", "FAST"); err != nil {
+		t.FailNow()
+	}
+
+	if _, err := env.GetPropertyEnum(
+		"mode",
+		[]string{"fast"},
+	); err == nil {
+		t.FailNow()
+	}
+
+	value, err := env.GetPropertyEnum(
+		"mode",
+		[]string{"fast"},
+		EnumCaseInsensitive(),
+	)
+	if err != nil || value != "fast" {
+		t.FailNow()
+	}
+}
+
+func TestGetPropertyEnumEmptyAllowedSet(t *testing.T) {
+	env := newAppTesterTenEnv()
+
+	if _, err := env.GetPropertyEnum("mode", nil); err == nil {
+		t.FailNow()
+	}
+}
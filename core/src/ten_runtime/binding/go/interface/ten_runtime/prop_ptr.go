@@ -0,0 +1,136 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+// #include "msg.h"
+// #include "ten_env.h"
+import "C"
+
+import "unsafe"
+
+// Pointer properties.
+//
+// A property can hold an arbitrary Go value as an opaque pointer, so
+// extensions in the same process can hand each other live Go objects without
+// serializing them. The rules:
+//
+//   - SetPropertyPtr pins the value: the binding keeps a reference, so the
+//     garbage collector will not reclaim it while the owning message or ten
+//     env property still exists. The pin is released when the C side destroys
+//     the property (tenUnpinGoPointer), at the latest when the owning message
+//     is destroyed.
+//
+//   - GetPropertyPtr returns the pinned value without unpinning it; the
+//     returned value is only guaranteed valid while the owning message is
+//     alive. Do not cache the property path and re-read it after the message
+//     is gone: once unpinned, the lookup returns nil.
+//
+//   - Delivery preserves identity within a process. A Cmd (or any other
+//     message) carrying a pointer property and sent with SendCmd to another
+//     Go extension in the same process delivers the very same Go value, not a
+//     copy: the property travels as an opaque handle and the receiver's
+//     GetPropertyPtr resolves it against the same process-wide table. This is
+//     the intended way to pass rich objects between in-process extensions
+//     without serialization.
+//
+//   - The value never crosses the process boundary. A pointer property is
+//     meaningless to a remote extension; only same-process graphs can use it.
+//     If a message carrying a pointer property does cross a process boundary,
+//     the receiver's GetPropertyPtr fails with a clear error instead of
+//     returning a dangling or unrelated value.
+//
+// SetProperty infers pointer storage automatically for values with no native
+// property representation; SetPropertyPtr makes the choice explicit and also
+// pins values (e.g. maps, slices) that SetProperty would otherwise convert to
+// object or array properties.
+
+// SetPropertyPtr stores value as an opaque pointer property, pinning it for
+// the lifetime of the property. See the package notes on pointer properties
+// above for the ownership rules.
+func (p *msg) SetPropertyPtr(path string, value any) error {
+	if len(path) == 0 {
+		return NewTenError(
+			ErrorCodeInvalidArgument,
+			"the property path is required.",
+		)
+	}
+
+	if value == nil {
+		return NewTenError(
+			ErrorCodeInvalidArgument,
+			"a nil value can not be stored as a pointer property.",
+		)
+	}
+
+	defer p.keepAlive()
+
+	return withCGOLimiter(func() error {
+		vh := newGoHandle(value)
+		apiStatus := C.ten_go_msg_property_set_ptr(
+			p.cPtr,
+			unsafe.Pointer(unsafe.StringData(path)),
+			C.int(len(path)),
+			cHandle(vh),
+		)
+
+		err := withCGoError(&apiStatus)
+		if err != nil {
+			// The C side did not take ownership; release the pin.
+			loadAndDeleteGoHandle(vh)
+		}
+		return err
+	})
+}
+
+// SetPropertyPtr stores value as an opaque pointer property on the ten env,
+// pinning it for the lifetime of the property. See the notes on pointer
+// properties in this file for the ownership rules.
+func (p *tenEnv) SetPropertyPtr(path string, value any) error {
+	if len(path) == 0 {
+		return NewTenError(
+			ErrorCodeInvalidArgument,
+			"the property path is required",
+		)
+	}
+
+	if value == nil {
+		return NewTenError(
+			ErrorCodeInvalidArgument,
+			"a nil value can not be stored as a pointer property.",
+		)
+	}
+
+	done := make(chan error, 1)
+
+	err := withCGOLimiter(func() error {
+		callbackHandle := newGoHandle(done)
+		vh := newGoHandle(value)
+
+		apiStatus := C.ten_go_ten_env_set_property_ptr(
+			p.cPtr,
+			unsafe.Pointer(unsafe.StringData(path)),
+			C.int(len(path)),
+			cHandle(vh),
+			C.uintptr_t(callbackHandle),
+		)
+
+		err := withCGoError(&apiStatus)
+		if err != nil {
+			// The C side did not take ownership; release the pins.
+			loadAndDeleteGoHandle(callbackHandle)
+			loadAndDeleteGoHandle(vh)
+		}
+		return err
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return <-done
+}
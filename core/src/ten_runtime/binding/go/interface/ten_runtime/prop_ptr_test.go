@@ -0,0 +1,73 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"testing"
+)
+
+type ptrPropPayload struct {
+	value int
+}
+
+func TestPointerPropertyPinAndLoad(t *testing.T) {
+	// Safe usage: while the property exists, the pin keeps the value
+	// reachable and GetPropertyPtr-style loads return it.
+	payload := &ptrPropPayload{value: 7}
+
+	pin := newGoHandle(payload)
+
+	loaded, ok := loadGoHandle(pin).(*ptrPropPayload)
+	if !ok || loaded.value != 7 {
+		t.FailNow()
+	}
+
+	// The same load twice is fine; loading does not unpin.
+	if loadGoHandle(pin) == nil {
+		t.FailNow()
+	}
+
+	loadAndDeleteGoHandle(pin)
+}
+
+func TestPointerPropertyLoadAfterUnpin(t *testing.T) {
+	// Failure mode: once the owning message is destroyed the C side unpins
+	// the value (tenUnpinGoPointer), and a stale load yields nil instead of
+	// the object.
+	payload := &ptrPropPayload{value: 7}
+
+	pin := newGoHandle(payload)
+	loadAndDeleteGoHandle(pin)
+
+	if loadGoHandle(pin) != nil {
+		t.FailNow()
+	}
+}
+
+func TestPointerPropertySameProcessIdentity(t *testing.T) {
+	// In-process delivery: the property travels as a handle, and resolving
+	// it on the receiving side yields the very same Go value the sender
+	// pinned, not a copy.
+	payload := &ptrPropPayload{value: 7}
+
+	// Sender side: pin the value when the property is set.
+	pin := newGoHandle(payload)
+
+	// Receiver side: resolve the handle carried by the delivered message.
+	received, ok := loadGoHandle(pin).(*ptrPropPayload)
+	if !ok || received != payload {
+		t.FailNow()
+	}
+
+	received.value = 8
+	if payload.value != 8 {
+		t.FailNow()
+	}
+
+	loadAndDeleteGoHandle(pin)
+}
@@ -0,0 +1,125 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+)
+
+// interpolateEnvString expands environment references in one string value:
+//
+//   - ${VAR} is replaced with the value of VAR ("" when unset).
+//   - ${VAR:-default} is replaced with the value of VAR, or the default when
+//     VAR is unset or empty.
+//   - $$ yields a literal dollar sign.
+//
+// Anything else is left untouched.
+func interpolateEnvString(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '$' {
+			b.WriteByte(s[i])
+			continue
+		}
+
+		if i+1 < len(s) && s[i+1] == '$' {
+			b.WriteByte('$')
+			i++
+			continue
+		}
+
+		if i+1 < len(s) && s[i+1] == '{' {
+			if end := strings.IndexByte(s[i+2:], '}'); end >= 0 {
+				ref := s[i+2 : i+2+end]
+
+				name, def, hasDef := strings.Cut(ref, ":-")
+				value := os.Getenv(name)
+				if value == "" && hasDef {
+					value = def
+				}
+
+				b.WriteString(value)
+				i += 2 + end
+				continue
+			}
+		}
+
+		b.WriteByte('$')
+	}
+
+	return b.String()
+}
+
+// interpolateEnvInValue walks a decoded json tree, expanding environment
+// references in every string value.
+func interpolateEnvInValue(value any) any {
+	switch v := value.(type) {
+	case string:
+		return interpolateEnvString(v)
+	case map[string]any:
+		for key, elem := range v {
+			v[key] = interpolateEnvInValue(elem)
+		}
+		return v
+	case []any:
+		for i, elem := range v {
+			v[i] = interpolateEnvInValue(elem)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// interpolateEnvInJSONBytes expands environment references in the string
+// values of a json document, so config files can reference secrets (ex:
+// "${OPENAI_API_KEY}") instead of committing them. The input is returned
+// unchanged when it contains no dollar sign.
+func interpolateEnvInJSONBytes(value []byte) ([]byte, error) {
+	if !strings.ContainsRune(string(value), '$') {
+		return value, nil
+	}
+
+	var tree any
+	decoder := json.NewDecoder(strings.NewReader(string(value)))
+	decoder.UseNumber()
+	if err := decoder.Decode(&tree); err != nil {
+		return nil, NewTenError(
+			ErrorCodeInvalidJSON,
+			err.Error(),
+		)
+	}
+
+	interpolated, err := json.Marshal(interpolateEnvInValue(tree))
+	if err != nil {
+		return nil, NewTenError(
+			ErrorCodeInvalidJSON,
+			err.Error(),
+		)
+	}
+
+	return interpolated, nil
+}
+
+// InitPropertyFromJSONBytesInterpolated initializes the property like
+// InitPropertyFromJSONBytes, after expanding environment references in the
+// json's string values. Interpolation is opt-in through this variant on
+// purpose: a property file with a literal "${VAR}" keeps its meaning under
+// InitPropertyFromJSONBytes, while apps that want secrets pulled from the
+// process environment call this one. An unset variable without a ":-"
+// default expands to the empty string.
+func (p *tenEnv) InitPropertyFromJSONBytesInterpolated(value []byte) error {
+	value, err := interpolateEnvInJSONBytes(value)
+	if err != nil {
+		return err
+	}
+
+	return p.InitPropertyFromJSONBytes(value)
+}
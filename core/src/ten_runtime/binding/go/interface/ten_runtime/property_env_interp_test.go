@@ -0,0 +1,59 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestInterpolateEnvString(t *testing.T) {
+	t.Setenv("TEN_INTERP_TEST", "secret")
+
+	if interpolateEnvString("${TEN_INTERP_TEST}") != "secret" {
+		t.FailNow()
+	}
+
+	if interpolateEnvString("a ${TEN_INTERP_TEST} b") != "a secret b" {
+		t.FailNow()
+	}
+
+	// Unset with a default falls back to the default.
+	if interpolateEnvString("${TEN_INTERP_UNSET:-fallback}") != "fallback" {
+		t.FailNow()
+	}
+
+	// Unset without a default expands to the empty string.
+	if interpolateEnvString("${TEN_INTERP_UNSET}") != "" {
+		t.FailNow()
+	}
+
+	// $$ escapes a literal dollar; malformed references stay untouched.
+	if interpolateEnvString("$$HOME") != "$HOME" {
+		t.FailNow()
+	}
+	if interpolateEnvString("${unterminated") != "${unterminated" {
+		t.FailNow()
+	}
+}
+
+func TestInterpolateEnvInJSONBytes(t *testing.T) {
+	t.Setenv("TEN_INTERP_TEST", "secret")
+
+	out, err := interpolateEnvInJSONBytes(
+		[]byte(`{"api_key": "${TEN_INTERP_TEST}", "count": 3}`),
+	)
+	if err != nil {
+		t.FailNow()
+	}
+
+	if !strings.Contains(string(out), `"api_key":"secret"`) ||
+		!strings.Contains(string(out), `"count":3`) {
+		t.FailNow()
+	}
+}
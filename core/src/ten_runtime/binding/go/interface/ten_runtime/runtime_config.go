@@ -0,0 +1,65 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"os"
+	"runtime"
+	"runtime/metrics"
+)
+
+// RuntimeConfigInfo is a read-only snapshot of the process-wide configuration
+// the runtime and the Go binding decided at init, for diagnosing environment
+// differences (ex: between CI and local runs) without logging each knob by
+// hand.
+type RuntimeConfigInfo struct {
+	// Go runtime.
+	GoVersion  string
+	GOOS       string
+	GOARCH     string
+	NumCPU     int
+	GOMAXPROCS int
+	GCPercent  int64
+	// MemoryLimitBytes is the GOMEMLIMIT soft limit; math.MaxInt64 means no
+	// limit is set.
+	MemoryLimitBytes int64
+
+	// Go binding.
+	//
+	// CgoLimiterTokens is the size of the token bucket bounding concurrent
+	// cgo calls, and thereby the OS threads the binding can create.
+	CgoLimiterTokens int
+	LeakCheckEnabled bool
+
+	// MemoryTrackingEnv is the raw value of TEN_ENABLE_MEMORY_TRACKING as
+	// seen by this process; the native tracker reads it once at init.
+	MemoryTrackingEnv string
+}
+
+// RuntimeConfig returns the active runtime-level configuration. It is pure
+// introspection: reading it changes nothing.
+func RuntimeConfig() RuntimeConfigInfo {
+	samples := []metrics.Sample{
+		{Name: "/gc/gogc:percent"},
+		{Name: "/gc/gomemlimit:bytes"},
+	}
+	metrics.Read(samples)
+
+	return RuntimeConfigInfo{
+		GoVersion:         runtime.Version(),
+		GOOS:              runtime.GOOS,
+		GOARCH:            runtime.GOARCH,
+		NumCPU:            runtime.NumCPU(),
+		GOMAXPROCS:        runtime.GOMAXPROCS(0),
+		GCPercent:         int64(samples[0].Value.Uint64()),
+		MemoryLimitBytes:  int64(samples[1].Value.Uint64()),
+		CgoLimiterTokens:  defaultTokenBuckets,
+		LeakCheckEnabled:  !leakCheckDisabled.Load(),
+		MemoryTrackingEnv: os.Getenv(MemoryTrackingEnvName),
+	}
+}
@@ -0,0 +1,47 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"runtime"
+	"testing"
+)
+
+func TestRuntimeConfigSnapshot(t *testing.T) {
+	config := RuntimeConfig()
+
+	if config.GoVersion != runtime.Version() {
+		t.FailNow()
+	}
+	if config.NumCPU != runtime.NumCPU() {
+		t.FailNow()
+	}
+	if config.GOMAXPROCS != runtime.GOMAXPROCS(0) {
+		t.FailNow()
+	}
+	if config.GCPercent <= 0 {
+		t.FailNow()
+	}
+	if config.CgoLimiterTokens != defaultTokenBuckets {
+		t.FailNow()
+	}
+}
+
+func TestRuntimeConfigTracksLeakCheckToggle(t *testing.T) {
+	defer SetLeakCheckEnabled(true)
+
+	SetLeakCheckEnabled(false)
+	if RuntimeConfig().LeakCheckEnabled {
+		t.FailNow()
+	}
+
+	SetLeakCheckEnabled(true)
+	if !RuntimeConfig().LeakCheckEnabled {
+		t.FailNow()
+	}
+}
@@ -11,8 +11,12 @@ package ten_runtime
 import "C"
 
 import (
+	"os"
 	"runtime"
 	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 	"unsafe"
 )
 
@@ -24,18 +28,145 @@ type (
 	// ErrorHandler is a function type that represents a handler for errors of a
 	// non-command type message.
 	ErrorHandler func(TenEnv, error)
+
+	// ResultHandlerWithCtx is a ResultHandler variant which additionally
+	// receives the user data passed to SendCmdExCtx, so callers can thread
+	// state into the callback without allocating a closure per send.
+	ResultHandlerWithCtx func(TenEnv, CmdResult, error, any)
 )
 
 // TenEnv represents the interface for the TEN (Run Time Environment) component.
 type TenEnv interface {
-	SendCmd(cmd Cmd, handler ResultHandler) error
+	// SendCmd sends the cmd and delivers its (final) result to the handler.
+	// Options adjust the single send; see WithNoResultHint.
+	SendCmd(cmd Cmd, handler ResultHandler, options ...SendCmdOption) error
 	SendCmdEx(cmd Cmd, handler ResultHandler) error
+
+	// TrySendCmd is SendCmd without blocking: it fails immediately with
+	// ErrBackpressure when the send path is saturated. Pair with
+	// SetMaxInFlightCmds for deterministic load shedding.
+	TrySendCmd(cmd Cmd, handler ResultHandler) error
+	SetMaxInFlightCmds(limit int)
+
+	// SendCmdExCtx behaves like SendCmdEx, but passes userData through to the
+	// handler so per-send state does not require a closure.
+	SendCmdExCtx(cmd Cmd, userData any, handler ResultHandlerWithCtx) error
+
+	// SendCmdRetry sends the cmd and retries it, per the policy, on transient
+	// send failures such as routing errors during graph reconfiguration.
+	// Permanent failures and error results from the destination are delivered
+	// to the handler without retrying.
+	SendCmdRetry(cmd Cmd, policy RetryPolicy, handler ResultHandler) error
+
+	// SendCmdWithCancel sends the cmd like SendCmdEx and returns a CancelFunc
+	// that asks the producer to stop emitting further streaming results. The
+	// producer observes the cancel via AcknowledgeCancelCmd and
+	// IsCmdCanceled.
+	SendCmdWithCancel(cmd Cmd, handler ResultHandler) (CancelFunc, error)
+
+	// AcknowledgeCancelCmd records the cancel signal carried by a received
+	// CancelCmdName cmd and returns an OK result to the canceler.
+	AcknowledgeCancelCmd(cancelCmd Cmd) error
+
+	// IsCmdCanceled reports whether the streaming cmd being served has been
+	// canceled by its sender.
+	IsCmdCanceled(cmd Cmd) bool
+
+	// SendCmdFinal sends the cmd and blocks until the completed result
+	// arrives, discarding intermediate streaming results. It must be called
+	// from a goroutine, not from a message-handling callback, as blocking
+	// the extension thread would prevent the result from being delivered.
+	SendCmdFinal(cmd Cmd) (CmdResult, error)
+
+	// SendJSON creates a cmd with the given name, loads its properties from
+	// the JSON object in propsJSON and sends it, as a shorthand for the
+	// NewCmd + SetProperty* + SendCmd sequence.
+	SendJSON(name string, propsJSON string, handler ResultHandler) error
+
+	// SendData sends the data. The handler receives nil once the runtime has
+	// routed the data into the destination extensions' input queues, or the
+	// failure cause; see SendDataWithResult for the precise semantics.
 	SendData(data Data, handler ErrorHandler) error
+
+	// SendDataWithResult sends the data and reports delivery progress
+	// (queued, delivered, failed) to the handler instead of a bare error.
+	SendDataWithResult(data Data, handler DataResultHandler) error
+
 	SendVideoFrame(videoFrame VideoFrame, handler ErrorHandler) error
 	SendAudioFrame(audioFrame AudioFrame, handler ErrorHandler) error
 
+	// SendToSelf routes the command back to the extension this ten env is
+	// attached to, so deferred work is delivered to its own OnCmd on the
+	// runtime thread instead of being run on an ad hoc goroutine.
+	SendToSelf(cmd Cmd, handler ResultHandler) error
+
+	// SendCmdFanOut sends a copy of cmd to every destination in dests and
+	// invokes handler once, after all destinations have settled with either
+	// a completed result or an error.
+	SendCmdFanOut(cmd Cmd, dests []Loc, handler FanOutResultHandler) error
+
+	// UseOutboundMiddleware registers middleware applied to every
+	// subsequent outbound send on this ten env; see OutboundMiddleware.
+	UseOutboundMiddleware(middleware OutboundMiddleware) error
+
+	// UseInboundMiddleware registers middleware applied to every
+	// subsequently received message, before the extension callback runs;
+	// see InboundMiddleware.
+	UseInboundMiddleware(middleware InboundMiddleware) error
+
+	// Go runs fn on a goroutine tracked by this ten env and joined before
+	// OnStopDone completes — the safe replacement for a bare `go` in an
+	// extension. GoStopping closes when the extension begins to stop.
+	Go(fn func(tenEnv TenEnv)) error
+	GoStopping() <-chan struct{}
+
+	// PendingResultCount reports how many result handlers handed to SendCmd,
+	// SendCmdEx or SendCmdExCtx are still waiting for their final result.
+	// SetOrphanedResultHandler installs a callback notified at OnStop for
+	// each handler still pending then; see OrphanedResultHandler.
+	PendingResultCount() int
+	SetOrphanedResultHandler(handler OrphanedResultHandler)
+
 	ReturnResult(result CmdResult, handler ErrorHandler) error
 
+	// SetReady declares whether the extension this ten env is attached to is
+	// ready to serve; the app aggregates the declarations via
+	// AllExtensionsReady for its health endpoint.
+	SetReady(ready bool) error
+
+	// GetBaseDir returns the install directory of the extension's package,
+	// so relative file paths can be resolved without changing the process
+	// working directory.
+	GetBaseDir() (string, error)
+
+	// GetManifest returns the package manifest (name, version, type,
+	// dependencies) of the extension this ten env is attached to, as parsed
+	// by the runtime.
+	GetManifest() (*Manifest, error)
+
+	// ListGraphs returns the predefined graphs of the app this ten env
+	// belongs to, so a graph name can be validated before starting it.
+	ListGraphs() ([]GraphInfo, error)
+
+	// GetConnectedExtensions returns which extensions the static graph
+	// wires to receive the given message name from this extension; empty
+	// when nothing is wired.
+	GetConnectedExtensions(msgName string) ([]string, error)
+
+	// SetPanicHandler registers a handler for panics escaping this
+	// extension's callbacks, so a deployment can turn them into controlled
+	// failures. See PanicHandler.
+	SetPanicHandler(handler PanicHandler) error
+
+	// Now returns the current time on the runtime's clock — the same clock
+	// that stamps Msg.GetTimestamp — so latency measurements do not mix the
+	// Go and runtime clocks across the cgo boundary.
+	Now() time.Time
+
+	// CloseApp requests an orderly shutdown of the app the extension belongs
+	// to, by sending the well-known close app command to the app itself.
+	CloseApp() error
+
 	OnConfigureDone() error
 	OnInitDone() error
 	OnStartDone() error
@@ -46,10 +177,64 @@ type TenEnv interface {
 	iProperty
 	InitPropertyFromJSONBytes(value []byte) error
 
+	// InitPropertyFromJSONBytesInterpolated initializes the property from the
+	// json bytes after expanding ${VAR} environment references in its string
+	// values, so config files can pull secrets from the process environment
+	// instead of committing them. InitPropertyFromJSONBytes leaves such
+	// references untouched.
+	InitPropertyFromJSONBytesInterpolated(value []byte) error
+
+	// InitPropertyFromJSONBytesWithOverrides initializes the property from
+	// the json bytes (normally the content of property.json), then applies
+	// the overrides on top: each key is a property path and an override beats
+	// the value from the file. This lets start-time configuration (ex: fields
+	// of a start request) be overlaid declaratively instead of editing the
+	// file.
+	InitPropertyFromJSONBytesWithOverrides(
+		value []byte,
+		overrides map[string]any,
+	) error
+
+	// Typed array accessors, built on top of the json property support.
+	GetPropertyInt64Array(path string) ([]int64, error)
+	SetPropertyInt64Array(path string, value []int64) error
+	GetPropertyFloat64Array(path string) ([]float64, error)
+	SetPropertyFloat64Array(path string, value []float64) error
+
+	// SetLocal stores a value in the goroutine-safe key/value store tied to
+	// the lifetime of this ten env. The store is released when the ten env is
+	// destroyed, so helper packages can keep per-extension scratch state
+	// without global maps.
+	SetLocal(key string, value any)
+
+	// GetLocal reads a value previously stored with SetLocal. The second
+	// return value reports whether the key is present.
+	GetLocal(key string) (any, bool)
+
+	// IsUnderTest reports whether the extension is running inside an
+	// ExtensionTester rather than a real graph. Use sparingly, ex: to
+	// disable external calls in tests.
+	IsUnderTest() bool
+
+	// StopReason reports, inside OnStop, why the extension is being stopped,
+	// so cleanup can distinguish a normal graph stop from a whole-app close.
+	StopReason() StopReason
+
 	LogDebug(msg string) error
 	LogInfo(msg string) error
 	LogWarn(msg string) error
 	LogError(msg string) error
+
+	// SetLogFile routes a copy of this ten env's log lines to its own
+	// file, so one extension's output is readable without the interleaving
+	// of a multi-extension app; an empty path disables the routing.
+	SetLogFile(path string) error
+
+	// LogErr logs msg at error level with err appended to the message and
+	// attached as a structured "error" field, unifying how errors appear in
+	// logs across extensions.
+	LogErr(msg string, err error) error
+
 	Log(
 		level LogLevel,
 		msg string,
@@ -87,6 +272,38 @@ type tenEnv struct {
 	baseTenObject[C.uintptr_t]
 
 	attachToType tenAttachTo
+
+	localsMu sync.Mutex
+	locals   map[string]any
+
+	watchdogMu sync.Mutex
+	watchdog   *time.Timer
+
+	panicMu      sync.Mutex
+	panicHandler PanicHandler
+
+	middlewareMu       sync.Mutex
+	outboundMiddleware []OutboundMiddleware
+	inboundMiddleware  []InboundMiddleware
+
+	// In-flight accounting for TrySendCmd; see SetMaxInFlightCmds.
+	inFlightCmds    atomic.Int64
+	maxInFlightCmds atomic.Int64
+
+	// goroutines tracks background goroutines started with Go; they are
+	// joined before OnStopDone is acknowledged.
+	goroutines goroutineTracker
+
+	// pendingResults maps result-handler handles awaiting their final result
+	// to the name of the cmd they were registered for; see PendingResultCount.
+	pendingResultsMu      sync.Mutex
+	pendingResults        map[goHandle]string
+	orphanedResultHandler OrphanedResultHandler
+
+	// logFile receives a copy of this ten env's log lines when set; see
+	// SetLogFile.
+	logFileMu sync.Mutex
+	logFile   *os.File
 }
 
 func (p *tenEnv) attachToExtension() {
@@ -105,7 +322,11 @@ func (p *tenEnv) attachToApp() {
 	p.attachToType = tenAttachToApp
 }
 
-func (p *tenEnv) SendCmd(cmd Cmd, handler ResultHandler) error {
+func (p *tenEnv) SendCmd(
+	cmd Cmd,
+	handler ResultHandler,
+	options ...SendCmdOption,
+) error {
 	if cmd == nil {
 		return NewTenError(
 			ErrorCodeInvalidArgument,
@@ -113,17 +334,58 @@ func (p *tenEnv) SendCmd(cmd Cmd, handler ResultHandler) error {
 		)
 	}
 
+	if opts := applySendCmdOptions(options); opts.noResultHint {
+		if handler != nil {
+			return NewTenError(
+				ErrorCodeInvalidArgument,
+				"a handler must not be provided together with WithNoResultHint.",
+			)
+		}
+
+		// Let the responder see that no result is expected.
+		if err := cmd.SetProperty(NoResultPropertyName, true); err != nil {
+			return err
+		}
+	}
+
+	cmd, err := p.outboundCmd(cmd)
+	if err != nil {
+		return err
+	}
+
+	if err := checkMessageLimits(cmd); err != nil {
+		return err
+	}
+
+	stampCmdTiming(cmd, timingEnqueue)
+
+	// The name is resolved before entering the limiter; a cgo call from
+	// inside a held slot would deadlock under load.
+	cmdName := resolveCmdName(cmd, handler)
+
 	return withCGOLimiter(func() error {
-		return p.sendCmd(cmd, handler)
+		return p.sendCmd(cmd, cmdName, handler)
 	})
 }
 
-func (p *tenEnv) sendCmd(cmd Cmd, handler ResultHandler) error {
+// resolveCmdName returns the cmd's name for pending-result tracking, or ""
+// when no handler will be registered.
+func resolveCmdName(cmd Cmd, handler ResultHandler) string {
+	if handler == nil {
+		return ""
+	}
+
+	cmdName, _ := cmd.GetName()
+	return cmdName
+}
+
+func (p *tenEnv) sendCmd(cmd Cmd, cmdName string, handler ResultHandler) error {
 	defer cmd.keepAlive()
 
 	cb := goHandleNil
 	if handler != nil {
 		cb = newGoHandle(handler)
+		p.trackPendingResult(cb, cmdName)
 	}
 
 	cStatus := C.ten_go_ten_env_send_cmd(
@@ -133,7 +395,13 @@ func (p *tenEnv) sendCmd(cmd Cmd, handler ResultHandler) error {
 		C.bool(false),
 	)
 
-	return withCGoError(&cStatus)
+	err := withCGoError(&cStatus)
+	if err != nil && cb != goHandleNil {
+		// The send failed synchronously; the handler will never fire.
+		p.completePendingResult(cb)
+	}
+
+	return err
 }
 
 func (p *tenEnv) SendCmdEx(cmd Cmd, handler ResultHandler) error {
@@ -144,17 +412,35 @@ func (p *tenEnv) SendCmdEx(cmd Cmd, handler ResultHandler) error {
 		)
 	}
 
+	cmd, err := p.outboundCmd(cmd)
+	if err != nil {
+		return err
+	}
+
+	if err := checkMessageLimits(cmd); err != nil {
+		return err
+	}
+
+	stampCmdTiming(cmd, timingEnqueue)
+
+	cmdName := resolveCmdName(cmd, handler)
+
 	return withCGOLimiter(func() error {
-		return p.sendCmdEx(cmd, handler)
+		return p.sendCmdEx(cmd, cmdName, handler)
 	})
 }
 
-func (p *tenEnv) sendCmdEx(cmd Cmd, handler ResultHandler) error {
+func (p *tenEnv) sendCmdEx(
+	cmd Cmd,
+	cmdName string,
+	handler ResultHandler,
+) error {
 	defer cmd.keepAlive()
 
 	cb := goHandleNil
 	if handler != nil {
 		cb = newGoHandle(handler)
+		p.trackPendingResult(cb, cmdName)
 	}
 
 	cStatus := C.ten_go_ten_env_send_cmd(
@@ -164,7 +450,21 @@ func (p *tenEnv) sendCmdEx(cmd Cmd, handler ResultHandler) error {
 		C.bool(true),
 	)
 
-	return withCGoError(&cStatus)
+	err := withCGoError(&cStatus)
+	if err != nil && cb != goHandleNil {
+		// The send failed synchronously; the handler will never fire.
+		p.completePendingResult(cb)
+	}
+
+	return err
+}
+
+func (p *tenEnv) Now() time.Time {
+	us, _ := withCGOLimiterHasReturnValue[int64](func() (int64, error) {
+		return int64(C.ten_go_now_us()), nil
+	})
+
+	return time.UnixMicro(us)
 }
 
 // Exported function to be called from C when the async operation in C
@@ -196,6 +496,15 @@ func (p *tenEnv) SendData(data Data, handler ErrorHandler) error {
 		)
 	}
 
+	data, err := p.outboundData(data)
+	if err != nil {
+		return err
+	}
+
+	if err := checkMessageLimits(data); err != nil {
+		return err
+	}
+
 	defer data.keepAlive()
 
 	cb := goHandleNil
@@ -203,7 +512,7 @@ func (p *tenEnv) SendData(data Data, handler ErrorHandler) error {
 		cb = newGoHandle(handler)
 	}
 
-	err := withCGOLimiter(func() error {
+	err = withCGOLimiter(func() error {
 		apiStatus := C.ten_go_ten_env_send_data(
 			p.cPtr,
 			data.getCPtr(),
@@ -232,6 +541,15 @@ func (p *tenEnv) SendVideoFrame(
 		)
 	}
 
+	videoFrame, err := p.outboundVideoFrame(videoFrame)
+	if err != nil {
+		return err
+	}
+
+	if err := checkMessageLimits(videoFrame); err != nil {
+		return err
+	}
+
 	defer videoFrame.keepAlive()
 
 	cb := goHandleNil
@@ -239,7 +557,7 @@ func (p *tenEnv) SendVideoFrame(
 		cb = newGoHandle(handler)
 	}
 
-	err := withCGOLimiter(func() error {
+	err = withCGOLimiter(func() error {
 		apiStatus := C.ten_go_ten_env_send_video_frame(
 			p.cPtr,
 			videoFrame.getCPtr(),
@@ -267,6 +585,15 @@ func (p *tenEnv) SendAudioFrame(
 		)
 	}
 
+	audioFrame, err := p.outboundAudioFrame(audioFrame)
+	if err != nil {
+		return err
+	}
+
+	if err := checkMessageLimits(audioFrame); err != nil {
+		return err
+	}
+
 	defer audioFrame.keepAlive()
 
 	cb := goHandleNil
@@ -274,7 +601,7 @@ func (p *tenEnv) SendAudioFrame(
 		cb = newGoHandle(handler)
 	}
 
-	err := withCGOLimiter(func() error {
+	err = withCGOLimiter(func() error {
 		apiStatus := C.ten_go_ten_env_send_audio_frame(
 			p.cPtr,
 			audioFrame.getCPtr(),
@@ -294,24 +621,45 @@ func (p *tenEnv) SendAudioFrame(
 func (p *tenEnv) OnConfigureDone() error {
 	p.LogDebug("OnConfigureDone")
 
+	if p.attachToType == tenAttachToApp {
+		// The app property is initialized by now; apply any Go runtime
+		// tuning it carries.
+		p.applyGoRuntimeConfig()
+	}
+
 	C.ten_go_ten_env_on_configure_done(p.cPtr)
 
 	return nil
 }
 
 func (p *tenEnv) OnInitDone() error {
+	p.disarmLifecycleWatchdog()
+
 	C.ten_go_ten_env_on_init_done(p.cPtr)
 
 	return nil
 }
 
 func (p *tenEnv) OnStartDone() error {
+	p.disarmLifecycleWatchdog()
+
 	C.ten_go_ten_env_on_start_done(p.cPtr)
 
 	return nil
 }
 
 func (p *tenEnv) OnStopDone() error {
+	p.disarmLifecycleWatchdog()
+
+	// The shutdown barrier: background goroutines started with Go are
+	// signaled and joined before the stop is acknowledged, so none of them
+	// can outlive the ten env they hold.
+	p.joinTrackedGoroutines()
+
+	// With the tracked goroutines drained, whatever is still pending now is
+	// a genuine orphan; surface each one.
+	p.reportOrphanedResults()
+
 	C.ten_go_ten_env_on_stop_done(p.cPtr)
 
 	return nil
@@ -320,6 +668,16 @@ func (p *tenEnv) OnStopDone() error {
 func (p *tenEnv) OnDeinitDone() error {
 	C.ten_go_ten_env_on_deinit_done(p.cPtr)
 
+	if p.attachToType == tenAttachToExtension {
+		// The instance is gone; its readiness declaration must not linger in
+		// the app-level aggregation.
+		p.clearReadiness()
+
+		// The extension is fully torn down; release the app's OnDeinit once
+		// the last one finishes.
+		liveExtensions.end()
+	}
+
 	return nil
 }
 
@@ -365,6 +723,26 @@ func (p *tenEnv) LogError(msg string) error {
 	return p.logInternal(LogLevelError, msg, nil, nil, nil)
 }
 
+func (p *tenEnv) LogErr(msg string, err error) error {
+	if err == nil {
+		return p.logInternal(LogLevelError, msg, nil, nil, nil)
+	}
+
+	// Attach the error as a structured field in addition to appending it to
+	// the message, so log collectors can filter on it.
+	fields := NewObjectValue(map[string]Value{
+		"error": NewStringValue(err.Error()),
+	})
+
+	return p.logInternal(
+		LogLevelError,
+		msg+": "+err.Error(),
+		nil,
+		&fields,
+		nil,
+	)
+}
+
 func (p *tenEnv) Log(
 	level LogLevel,
 	msg string,
@@ -386,6 +764,8 @@ func (p *tenEnv) logInternal(
 		option = &DefaultLogOption
 	}
 
+	p.writeLogFile(level, msg)
+
 	// Get caller info.
 	pc, fileName, lineNo, ok := runtime.Caller(option.Skip)
 	funcName := "unknown"
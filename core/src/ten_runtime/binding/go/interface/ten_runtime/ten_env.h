@@ -59,6 +59,49 @@ void ten_go_ten_env_finalize(uintptr_t bridge_addr);
 
 const char *ten_go_ten_env_debug_info(uintptr_t bridge_addr);
 
+// Returns the name of the extension this ten env is attached to, or NULL when
+// the ten env is not attached to an extension. The returned string is
+// allocated and must be freed by the caller.
+const char *ten_go_ten_env_get_attached_extension_name(uintptr_t bridge_addr);
+
+/**
+ * @brief Serialize the manifest of the extension this ten env is attached to
+ * as a JSON string. Returns NULL if the ten env is not attached to an
+ * extension. The returned string is owned by the GO world and must be freed
+ * there.
+ */
+const char *ten_go_ten_env_get_manifest_json(uintptr_t bridge_addr);
+
+/**
+ * @brief Get the base directory of the extension package this ten env is
+ * attached to, i.e. the directory the extension's files were installed into.
+ * Returns NULL if the ten env is not attached to an extension or the base dir
+ * is unknown. The returned string is owned by the GO world and must be freed
+ * there.
+ */
+const char *ten_go_ten_env_get_base_dir(uintptr_t bridge_addr);
+
+/**
+ * @brief Serialize the predefined graphs of the app this ten env belongs to
+ * as a JSON array of {name, auto_start, singleton} objects. Returns NULL if
+ * the belonging app cannot be resolved. The returned string is owned by the
+ * GO world and must be freed there.
+ */
+const char *ten_go_ten_env_list_graphs_json(uintptr_t bridge_addr);
+
+const char *ten_go_ten_env_get_connected_extensions_json(uintptr_t bridge_addr,
+                                                         const void *msg_name,
+                                                         int msg_name_len);
+
+// Returns true when the extension this ten env is attached to is hosted by a
+// standalone test app (ExtensionTester), false otherwise.
+bool ten_go_ten_env_is_under_test(uintptr_t bridge_addr);
+
+// Returns why the extension is being stopped: 0 for a normal graph stop, 1
+// when the whole app is closing. Matches the StopReason values on the GO
+// side.
+int ten_go_ten_env_get_stop_reason(uintptr_t bridge_addr);
+
 // In general, there are two ways to get a property from a ten_env_t instance.
 //
 // - One is getting property based on an explicit type, ex:
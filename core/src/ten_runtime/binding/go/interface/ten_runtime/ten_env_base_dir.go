@@ -0,0 +1,36 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+// #include <stdlib.h>
+// #include "ten_env.h"
+import "C"
+
+import "unsafe"
+
+// GetBaseDir returns the directory the extension's package was installed
+// into, so the extension can resolve its own files relative to it instead of
+// calling os.Chdir — changing the process working directory is global and
+// racy when several extensions (or apps) coexist in one process.
+func (p *tenEnv) GetBaseDir() (string, error) {
+	defer p.keepAlive()
+
+	cStr, _ := withCGOLimiterHasReturnValue[*C.char](func() (*C.char, error) {
+		return C.ten_go_ten_env_get_base_dir(p.cPtr), nil
+	})
+
+	if cStr == nil {
+		return "", NewTenError(
+			ErrorCodeGeneric,
+			"GetBaseDir is only supported on a ten env attached to an extension.",
+		)
+	}
+	defer C.free(unsafe.Pointer(cStr))
+
+	return C.GoString(cStr), nil
+}
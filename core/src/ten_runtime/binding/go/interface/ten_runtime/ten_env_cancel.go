@@ -0,0 +1,189 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// CancelCmdName is the name of the well-known cmd carrying a cancel
+	// signal for an in-flight streaming cmd. Like any other cmd, it is routed
+	// by the graph, so a connection for this name must be declared between
+	// the canceling extension and the producer.
+	CancelCmdName = "ten:cancel"
+
+	// CancelIDPropertyName is the property holding the correlation id that
+	// ties a cancel signal to the streaming cmd it cancels. SendCmdWithCancel
+	// stamps it on the outgoing cmd, and the cancel cmd carries the same id.
+	CancelIDPropertyName = "ten:cancel_id"
+)
+
+// CancelFunc asks the producer of an in-flight streaming cmd to stop emitting
+// further results. Calling it more than once is harmless; only the first call
+// sends the cancel signal.
+type CancelFunc func() error
+
+var cancelIDCounter atomic.Uint64
+
+func nextCancelID() string {
+	return strconv.FormatUint(cancelIDCounter.Add(1), 10)
+}
+
+// canceledCmds tracks, on the producer side, the cancel ids that have been
+// acknowledged but whose streaming cmd has not yet returned its final result.
+// It is process-wide rather than per ten env, since a cancel cmd and the
+// streaming cmd it targets may be handled by different extensions of the same
+// addon.
+var canceledCmds struct {
+	sync.Mutex
+	ids map[string]struct{}
+}
+
+// canceledCmdGracePeriod bounds how long an acknowledged cancel is retained.
+// A producer's natural reaction to a cancel is to stop emitting without ever
+// returning a final result, so an entry can not count on ReturnResult for its
+// cleanup; it expires on its own instead. Cancel ids are never reused, so an
+// expired entry can not be confused with a later cmd — the only consequence
+// is that IsCmdCanceled stops reporting the cancel after the grace period.
+const canceledCmdGracePeriod = 5 * time.Minute
+
+func markCmdCanceled(id string) {
+	canceledCmds.Lock()
+	defer canceledCmds.Unlock()
+
+	if canceledCmds.ids == nil {
+		canceledCmds.ids = make(map[string]struct{})
+	}
+	canceledCmds.ids[id] = struct{}{}
+
+	time.AfterFunc(canceledCmdGracePeriod, func() {
+		clearCmdCanceled(id)
+	})
+}
+
+func isCmdCanceled(id string) bool {
+	canceledCmds.Lock()
+	defer canceledCmds.Unlock()
+
+	_, ok := canceledCmds.ids[id]
+	return ok
+}
+
+func clearCmdCanceled(id string) {
+	canceledCmds.Lock()
+	defer canceledCmds.Unlock()
+
+	delete(canceledCmds.ids, id)
+}
+
+// SendCmdWithCancel sends the cmd like SendCmdEx, allowing multiple streaming
+// results, and returns a CancelFunc the caller can invoke to tell the
+// producer to stop early (ex: the user interrupted a long LLM response).
+//
+// Cancellation is cooperative: the producer observes it either by handling
+// the CancelCmdName cmd with AcknowledgeCancelCmd, or by polling
+// IsCmdCanceled between results. Results already in flight when the cancel is
+// sent are still delivered to the handler.
+func (p *tenEnv) SendCmdWithCancel(
+	cmd Cmd,
+	handler ResultHandler,
+) (CancelFunc, error) {
+	if cmd == nil {
+		return nil, NewTenError(
+			ErrorCodeInvalidArgument,
+			"cmd is required.",
+		)
+	}
+
+	cancelID := nextCancelID()
+	if err := cmd.SetPropertyString(CancelIDPropertyName, cancelID); err != nil {
+		return nil, err
+	}
+
+	if err := p.SendCmdEx(cmd, handler); err != nil {
+		return nil, err
+	}
+
+	var once sync.Once
+	var cancelErr error
+
+	cancel := func() error {
+		once.Do(func() {
+			cancelCmd, err := NewCmd(CancelCmdName)
+			if err != nil {
+				cancelErr = err
+				return
+			}
+
+			if err := cancelCmd.SetPropertyString(
+				CancelIDPropertyName,
+				cancelID,
+			); err != nil {
+				cancelErr = err
+				return
+			}
+
+			cancelErr = p.SendCmd(cancelCmd, nil)
+		})
+
+		return cancelErr
+	}
+
+	return cancel, nil
+}
+
+// AcknowledgeCancelCmd records the cancel signal carried by a CancelCmdName
+// cmd and returns an OK result to the canceler. Call it from OnCmd when the
+// received cmd name is CancelCmdName; afterwards IsCmdCanceled reports true
+// for the targeted streaming cmd.
+func (p *tenEnv) AcknowledgeCancelCmd(cancelCmd Cmd) error {
+	if cancelCmd == nil {
+		return NewTenError(
+			ErrorCodeInvalidArgument,
+			"cancelCmd is required.",
+		)
+	}
+
+	id, err := cancelCmd.GetPropertyString(CancelIDPropertyName)
+	if err != nil {
+		return NewTenError(
+			ErrorCodeInvalidArgument,
+			"not a cancel cmd, it carries no cancel id: "+err.Error(),
+		)
+	}
+
+	markCmdCanceled(id)
+
+	result, err := NewCmdResult(StatusCodeOk, cancelCmd)
+	if err != nil {
+		return err
+	}
+
+	return p.ReturnResult(result, nil)
+}
+
+// IsCmdCanceled reports whether the streaming cmd currently being served has
+// been canceled by its sender. A producer emitting results in a loop should
+// check it between results and stop producing once it returns true. A cmd
+// sent without SendCmdWithCancel is never reported as canceled.
+func (p *tenEnv) IsCmdCanceled(cmd Cmd) bool {
+	if cmd == nil {
+		return false
+	}
+
+	id, err := cmd.GetPropertyString(CancelIDPropertyName)
+	if err != nil || id == "" {
+		return false
+	}
+
+	return isCmdCanceled(id)
+}
@@ -0,0 +1,60 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+// #include <stdlib.h>
+// #include "ten_env.h"
+import "C"
+
+import (
+	"encoding/json"
+	"unsafe"
+)
+
+// GetConnectedExtensions returns the names of the extensions wired in the
+// static graph to receive the given message name from this extension, across
+// all message types. A router can use it to make dynamic decisions, ex: skip
+// building a message that has no consumer. The slice is empty when nothing
+// is wired.
+func (p *tenEnv) GetConnectedExtensions(msgName string) ([]string, error) {
+	if len(msgName) == 0 {
+		return nil, NewTenError(
+			ErrorCodeInvalidArgument,
+			"msg name is required.",
+		)
+	}
+
+	defer p.keepAlive()
+
+	cStr, _ := withCGOLimiterHasReturnValue[*C.char](func() (*C.char, error) {
+		return C.ten_go_ten_env_get_connected_extensions_json(
+			p.cPtr,
+			unsafe.Pointer(unsafe.StringData(msgName)),
+			C.int(len(msgName)),
+		), nil
+	})
+
+	if cStr == nil {
+		return nil, NewTenError(
+			ErrorCodeGeneric,
+			"GetConnectedExtensions is only available to an extension "+
+				"inside a graph.",
+		)
+	}
+	defer C.free(unsafe.Pointer(cStr))
+
+	var names []string
+	if err := json.Unmarshal([]byte(C.GoString(cStr)), &names); err != nil {
+		return nil, NewTenError(
+			ErrorCodeInvalidJSON,
+			"the connected extension list is not valid JSON.",
+		)
+	}
+
+	return names, nil
+}
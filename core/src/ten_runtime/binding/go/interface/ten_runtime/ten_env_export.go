@@ -38,6 +38,7 @@ func tenGoDestroyTenEnv(tenEnvObjID C.uintptr_t) {
 	r, ok := handle(tenEnvObjID).free().(*tenEnv)
 
 	r.attachToType = tenAttachToInvalid
+	r.clearLocals()
 
 	if !ok {
 		panic(
@@ -79,6 +80,11 @@ func tenGoOnCmdResult(
 	var cb any = nil
 	if isCompleted {
 		cb = loadAndDeleteGoHandle(goHandle(resultHandler))
+
+		// The final result has arrived; the handler is no longer pending.
+		if te, isRuntimeEnv := tenEnvObj.(*tenEnv); isRuntimeEnv {
+			te.completePendingResult(goHandle(resultHandler))
+		}
 	} else {
 		cb = loadGoHandle(goHandle(resultHandler))
 	}
@@ -90,7 +96,15 @@ func tenGoOnCmdResult(
 
 	err := withCGoError(&cgoError)
 
-	cb.(ResultHandler)(tenEnvObj, cr, err)
+	switch cb := cb.(type) {
+	case ResultHandler:
+		cb(tenEnvObj, cr, err)
+	case *resultHandlerWithCtx:
+		cb.handler(tenEnvObj, cr, err, cb.userData)
+	default:
+		// Should not happen.
+		panic("The result handler has an unexpected type.")
+	}
 }
 
 //export tenGoOnError
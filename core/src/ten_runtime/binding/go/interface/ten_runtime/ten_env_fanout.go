@@ -0,0 +1,148 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import "sync"
+
+// FanOutResultHandler receives the collected outcome of a fan-out send.
+// results[i] and errs[i] describe the destination dests[i] of the
+// SendCmdFanOut call: exactly one of the two is set per destination.
+type FanOutResultHandler func(tenEnv TenEnv, results []CmdResult, errs []error)
+
+// fanOutState collects per-destination outcomes and fires the user handler
+// exactly once, when the last destination settles.
+type fanOutState struct {
+	mu      sync.Mutex
+	results []CmdResult
+	errs    []error
+	settled []bool
+	pending int
+}
+
+func newFanOutState(n int) *fanOutState {
+	return &fanOutState{
+		results: make([]CmdResult, n),
+		errs:    make([]error, n),
+		settled: make([]bool, n),
+		pending: n,
+	}
+}
+
+// settle records the outcome for one destination. It returns true when this
+// settle was the last one, i.e. the aggregate handler must fire now.
+func (s *fanOutState) settle(idx int, result CmdResult, err error) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.settled[idx] {
+		return false
+	}
+	s.settled[idx] = true
+
+	s.results[idx] = result
+	s.errs[idx] = err
+
+	s.pending--
+	return s.pending == 0
+}
+
+// SendCmdFanOut sends a copy of cmd to every destination in dests and invokes
+// handler exactly once, after all destinations have delivered their completed
+// result or failed. This is the common splitter/aggregator pattern: fire the
+// same command at N extensions and collect all N outcomes.
+//
+// Partial failures do not abort the fan-out: a destination that fails to send
+// or returns an error settles with that error in errs[i], while the others
+// proceed normally. Intermediate (streaming) results are discarded; only the
+// completed result of each destination is collected.
+func (p *tenEnv) SendCmdFanOut(
+	cmd Cmd,
+	dests []Loc,
+	handler FanOutResultHandler,
+) error {
+	if cmd == nil {
+		return NewTenError(
+			ErrorCodeInvalidArgument,
+			"cmd is required.",
+		)
+	}
+
+	if len(dests) == 0 {
+		return NewTenError(
+			ErrorCodeInvalidArgument,
+			"at least one destination is required.",
+		)
+	}
+
+	if handler == nil {
+		return NewTenError(
+			ErrorCodeInvalidArgument,
+			"handler is required.",
+		)
+	}
+
+	// Clone up front so a clone failure surfaces before anything is sent;
+	// the original cmd serves the first destination.
+	cmds := make([]Cmd, len(dests))
+	cmds[0] = cmd
+	for i := 1; i < len(dests); i++ {
+		clone, err := cmd.Clone()
+		if err != nil {
+			return err
+		}
+		cmds[i] = clone
+	}
+
+	state := newFanOutState(len(dests))
+
+	for i := range dests {
+		idx := i
+
+		perDest := func(tenEnvObj TenEnv, result CmdResult, err error) {
+			if err != nil {
+				if state.settle(idx, nil, err) {
+					handler(tenEnvObj, state.results, state.errs)
+				}
+				return
+			}
+
+			completed, completedErr := result.IsCompleted()
+			if completedErr != nil {
+				if state.settle(idx, nil, completedErr) {
+					handler(tenEnvObj, state.results, state.errs)
+				}
+				return
+			}
+
+			if !completed {
+				// A streaming intermediate result; wait for the
+				// completed one.
+				return
+			}
+
+			if state.settle(idx, result, nil) {
+				handler(tenEnvObj, state.results, state.errs)
+			}
+		}
+
+		if err := cmds[idx].SetDests(dests[idx]); err != nil {
+			if state.settle(idx, nil, err) {
+				handler(p, state.results, state.errs)
+			}
+			continue
+		}
+
+		if err := p.SendCmdEx(cmds[idx], perDest); err != nil {
+			if state.settle(idx, nil, err) {
+				handler(p, state.results, state.errs)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestFanOutStateFiresOnLastSettle(t *testing.T) {
+	state := newFanOutState(3)
+
+	if state.settle(0, nil, nil) {
+		t.FailNow()
+	}
+	if state.settle(1, nil, errors.New("boom")) {
+		t.FailNow()
+	}
+	if !state.settle(2, nil, nil) {
+		t.FailNow()
+	}
+
+	if state.errs[0] != nil || state.errs[1] == nil || state.errs[2] != nil {
+		t.FailNow()
+	}
+}
+
+func TestFanOutStateIgnoresDoubleSettle(t *testing.T) {
+	state := newFanOutState(2)
+
+	if state.settle(0, nil, nil) {
+		t.FailNow()
+	}
+
+	// A late duplicate for an already-settled destination must neither fire
+	// the handler nor overwrite the recorded outcome.
+	if state.settle(0, nil, errors.New("late")) {
+		t.FailNow()
+	}
+	if state.errs[0] != nil {
+		t.FailNow()
+	}
+
+	if !state.settle(1, nil, nil) {
+		t.FailNow()
+	}
+}
+
+func TestFanOutRejectsInvalidArguments(t *testing.T) {
+	env := &tenEnv{}
+
+	noop := func(TenEnv, []CmdResult, []error) {}
+
+	if err := env.SendCmdFanOut(nil, []Loc{{}}, noop); err == nil {
+		t.FailNow()
+	}
+	if err := env.SendCmdFanOut(&cmd{}, nil, noop); err == nil {
+		t.FailNow()
+	}
+	if err := env.SendCmdFanOut(&cmd{}, []Loc{{}}, nil); err == nil {
+		t.FailNow()
+	}
+}
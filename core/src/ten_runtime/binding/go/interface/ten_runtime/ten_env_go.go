@@ -0,0 +1,107 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import "sync"
+
+// goroutineTracker joins an extension's background goroutines at shutdown:
+// a shutdown barrier. It is a WaitGroup plus a stop channel the goroutines
+// can select on, so shutdown both signals them and waits for them.
+type goroutineTracker struct {
+	wg sync.WaitGroup
+
+	mu       sync.Mutex
+	stop     chan struct{}
+	stopping bool
+}
+
+// stopChan lazily creates the stop channel.
+func (t *goroutineTracker) stopChan() chan struct{} {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.stop == nil {
+		t.stop = make(chan struct{})
+	}
+
+	return t.stop
+}
+
+// begin registers one goroutine; it fails once shutdown has started.
+func (t *goroutineTracker) begin() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.stopping {
+		return false
+	}
+
+	t.wg.Add(1)
+	return true
+}
+
+// shutdown signals the goroutines and joins them.
+func (t *goroutineTracker) shutdown() {
+	t.mu.Lock()
+	if !t.stopping {
+		t.stopping = true
+		if t.stop == nil {
+			t.stop = make(chan struct{})
+		}
+		close(t.stop)
+	}
+	t.mu.Unlock()
+
+	t.wg.Wait()
+}
+
+// Go runs fn on a goroutine tracked by this ten env, the safe replacement
+// for a bare `go func() {...}` in an extension: the goroutine is joined
+// before OnStopDone reaches the runtime, so the tenEnv handed to fn stays
+// valid for the goroutine's whole tracked lifetime and the expired-tenEnv
+// bug class is ruled out by construction.
+//
+// fn must return for the extension to stop; long-running work should select
+// on GoStopping and wind down when it closes. Starting a tracked goroutine
+// after shutdown has begun fails.
+func (p *tenEnv) Go(fn func(tenEnv TenEnv)) error {
+	if fn == nil {
+		return NewTenError(
+			ErrorCodeInvalidArgument,
+			"fn is required.",
+		)
+	}
+
+	if !p.goroutines.begin() {
+		return NewTenError(
+			ErrorCodeGeneric,
+			"the extension is stopping; no new tracked goroutines.",
+		)
+	}
+
+	go func() {
+		defer p.goroutines.wg.Done()
+		fn(p)
+	}()
+
+	return nil
+}
+
+// GoStopping returns a channel that closes when the extension begins to
+// stop; tracked goroutines select on it to wind down in time. A goroutine
+// that ignores it blocks OnStopDone until it returns.
+func (p *tenEnv) GoStopping() <-chan struct{} {
+	return p.goroutines.stopChan()
+}
+
+// joinTrackedGoroutines is the shutdown barrier, invoked from OnStopDone:
+// signal every tracked goroutine and wait for all of them to return before
+// the stop is acknowledged to the runtime.
+func (p *tenEnv) joinTrackedGoroutines() {
+	p.goroutines.shutdown()
+}
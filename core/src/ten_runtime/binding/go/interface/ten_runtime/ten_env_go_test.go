@@ -0,0 +1,60 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestGoroutineTrackerJoinsOnShutdown(t *testing.T) {
+	env := newAppTesterTenEnv()
+
+	var finished atomic.Bool
+	err := env.Go(func(tenEnv TenEnv) {
+		<-tenEnv.GoStopping()
+		finished.Store(true)
+	})
+	if err != nil {
+		t.FailNow()
+	}
+
+	// OnStopDone is the barrier: it signals the goroutine and joins it.
+	env.OnStopDone()
+	if !finished.Load() {
+		t.FailNow()
+	}
+}
+
+func TestGoAfterShutdownFails(t *testing.T) {
+	env := newAppTesterTenEnv()
+	env.OnStopDone()
+
+	if err := env.Go(func(tenEnv TenEnv) {}); err == nil {
+		t.FailNow()
+	}
+}
+
+func TestGoRequiresFn(t *testing.T) {
+	env := newAppTesterTenEnv()
+
+	if err := env.Go(nil); err == nil {
+		t.FailNow()
+	}
+}
+
+func TestGoStoppingOpenWhileRunning(t *testing.T) {
+	env := newAppTesterTenEnv()
+
+	select {
+	case <-env.GoStopping():
+		t.FailNow()
+	case <-time.After(10 * time.Millisecond):
+	}
+}
@@ -0,0 +1,55 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+// #include <stdlib.h>
+// #include "ten_env.h"
+import "C"
+
+import (
+	"encoding/json"
+	"unsafe"
+)
+
+// GraphInfo describes one predefined graph of the app, as declared in the
+// app's property.json under predefined_graphs.
+type GraphInfo struct {
+	Name      string `json:"name"`
+	AutoStart bool   `json:"auto_start"`
+	Singleton bool   `json:"singleton"`
+}
+
+// ListGraphs returns the predefined graphs of the app this ten env belongs
+// to. Callers can use it to validate a requested graph name up front and
+// fail with a clear error, instead of starting a graph that the app never
+// defined and diagnosing the failure later.
+func (p *tenEnv) ListGraphs() ([]GraphInfo, error) {
+	defer p.keepAlive()
+
+	cStr, _ := withCGOLimiterHasReturnValue[*C.char](func() (*C.char, error) {
+		return C.ten_go_ten_env_list_graphs_json(p.cPtr), nil
+	})
+
+	if cStr == nil {
+		return nil, NewTenError(
+			ErrorCodeGeneric,
+			"ListGraphs could not resolve the app this ten env belongs to.",
+		)
+	}
+	defer C.free(unsafe.Pointer(cStr))
+
+	var graphs []GraphInfo
+	if err := json.Unmarshal([]byte(C.GoString(cStr)), &graphs); err != nil {
+		return nil, NewTenError(
+			ErrorCodeInvalidJSON,
+			"the predefined graph list is not valid JSON.",
+		)
+	}
+
+	return graphs, nil
+}
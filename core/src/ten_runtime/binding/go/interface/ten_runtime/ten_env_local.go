@@ -0,0 +1,36 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+func (p *tenEnv) SetLocal(key string, value any) {
+	p.localsMu.Lock()
+	defer p.localsMu.Unlock()
+
+	if p.locals == nil {
+		p.locals = make(map[string]any)
+	}
+
+	p.locals[key] = value
+}
+
+func (p *tenEnv) GetLocal(key string) (any, bool) {
+	p.localsMu.Lock()
+	defer p.localsMu.Unlock()
+
+	value, ok := p.locals[key]
+	return value, ok
+}
+
+// clearLocals releases the per-extension store; called when the ten env is
+// destroyed so stashed values do not outlive the extension.
+func (p *tenEnv) clearLocals() {
+	p.localsMu.Lock()
+	defer p.localsMu.Unlock()
+
+	p.locals = nil
+}
@@ -0,0 +1,83 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"fmt"
+	"os"
+	"time"
+)
+
+// logLevelName maps a level to the tag written in per-extension log files.
+func logLevelName(level LogLevel) string {
+	switch level {
+	case LogLevelDebug:
+		return "DEBUG"
+	case LogLevelInfo:
+		return "INFO"
+	case LogLevelWarn:
+		return "WARN"
+	case LogLevelError:
+		return "ERROR"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// SetLogFile routes this ten env's logs to their own file, in addition to
+// the runtime's shared logger, so one extension's output can be followed
+// without the interleaving of a multi-extension app. The file is opened in
+// append mode and created if missing. An empty path disables the routing
+// and closes the current file.
+//
+// Level filtering stays with the runtime logger; the file receives every
+// line this ten env logs, tagged with its level.
+func (p *tenEnv) SetLogFile(path string) error {
+	p.logFileMu.Lock()
+	defer p.logFileMu.Unlock()
+
+	if p.logFile != nil {
+		p.logFile.Close()
+		p.logFile = nil
+	}
+
+	if len(path) == 0 {
+		return nil
+	}
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return NewTenError(
+			ErrorCodeGeneric,
+			"failed to open the log file: "+err.Error(),
+		)
+	}
+
+	p.logFile = f
+	return nil
+}
+
+// writeLogFile appends one line to the per-extension log file, when one is
+// configured. Write failures are swallowed: logging must never fail the
+// operation being logged.
+func (p *tenEnv) writeLogFile(level LogLevel, msg string) {
+	p.logFileMu.Lock()
+	defer p.logFileMu.Unlock()
+
+	if p.logFile == nil {
+		return
+	}
+
+	fmt.Fprintf(
+		p.logFile,
+		"%s %s %s\n",
+		time.Now().Format(time.RFC3339),
+		logLevelName(level),
+		msg,
+	)
+}
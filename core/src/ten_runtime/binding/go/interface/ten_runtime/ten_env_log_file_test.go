@@ -0,0 +1,61 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSetLogFileWritesLines(t *testing.T) {
+	env := &tenEnv{}
+	path := filepath.Join(t.TempDir(), "ext.log")
+
+	if err := env.SetLogFile(path); err != nil {
+		t.FailNow()
+	}
+
+	env.writeLogFile(LogLevelInfo, "hello")
+	env.writeLogFile(LogLevelError, "boom")
+
+	if err := env.SetLogFile(""); err != nil {
+		t.FailNow()
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.FailNow()
+	}
+
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	if len(lines) != 2 {
+		t.FailNow()
+	}
+	if !strings.Contains(lines[0], "INFO hello") {
+		t.FailNow()
+	}
+	if !strings.Contains(lines[1], "ERROR boom") {
+		t.FailNow()
+	}
+}
+
+func TestWriteLogFileWithoutFileIsNoop(t *testing.T) {
+	env := &tenEnv{}
+	env.writeLogFile(LogLevelInfo, "dropped")
+}
+
+func TestSetLogFileBadPath(t *testing.T) {
+	env := &tenEnv{}
+	if err := env.SetLogFile(
+		filepath.Join(t.TempDir(), "missing", "ext.log"),
+	); err == nil {
+		t.FailNow()
+	}
+}
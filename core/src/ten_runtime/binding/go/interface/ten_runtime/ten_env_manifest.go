@@ -0,0 +1,64 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+// #include <stdlib.h>
+// #include "ten_env.h"
+import "C"
+
+import (
+	"encoding/json"
+	"unsafe"
+)
+
+// Manifest carries the package metadata the runtime parsed from the
+// extension's manifest.json, for compatibility checks and diagnostics (e.g.
+// logging each loaded extension's version).
+type Manifest struct {
+	Type         string               `json:"type"`
+	Name         string               `json:"name"`
+	Version      string               `json:"version"`
+	Dependencies []ManifestDependency `json:"dependencies,omitempty"`
+}
+
+// ManifestDependency is one entry of the manifest's dependency list.
+type ManifestDependency struct {
+	Type    string `json:"type"`
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// GetManifest returns the manifest of the extension this ten env is attached
+// to, as parsed by the runtime when the package was loaded. It fails on a ten
+// env that is not attached to an extension (e.g. an app ten env), as only
+// extensions ship a package manifest.
+func (p *tenEnv) GetManifest() (*Manifest, error) {
+	defer p.keepAlive()
+
+	cStr, _ := withCGOLimiterHasReturnValue[*C.char](func() (*C.char, error) {
+		return C.ten_go_ten_env_get_manifest_json(p.cPtr), nil
+	})
+
+	if cStr == nil {
+		return nil, NewTenError(
+			ErrorCodeGeneric,
+			"GetManifest is only supported on a ten env attached to an extension.",
+		)
+	}
+	defer C.free(unsafe.Pointer(cStr))
+
+	var manifest Manifest
+	if err := json.Unmarshal([]byte(C.GoString(cStr)), &manifest); err != nil {
+		return nil, NewTenError(
+			ErrorCodeInvalidJSON,
+			"the manifest of the extension is not valid JSON.",
+		)
+	}
+
+	return &manifest, nil
+}
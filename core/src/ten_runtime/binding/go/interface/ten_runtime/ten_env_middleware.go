@@ -0,0 +1,123 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+// OutboundMiddleware sees every outbound message of a ten env before it is
+// sent, for cross-cutting concerns like trace IDs, secret redaction, or
+// metrics. It may mutate the message in place and return it, or return a
+// replacement message of the same kind. Returning an error aborts the send
+// with that error; returning a nil message keeps the current one.
+type OutboundMiddleware func(msg Msg) (Msg, error)
+
+// UseOutboundMiddleware registers middleware applied to every subsequent
+// SendCmd/SendData/SendVideoFrame/SendAudioFrame call on this ten env, in
+// registration order. This keeps the logic out of the individual send sites.
+func (p *tenEnv) UseOutboundMiddleware(middleware OutboundMiddleware) error {
+	if middleware == nil {
+		return NewTenError(
+			ErrorCodeInvalidArgument,
+			"middleware is required.",
+		)
+	}
+
+	p.middlewareMu.Lock()
+	defer p.middlewareMu.Unlock()
+	p.outboundMiddleware = append(p.outboundMiddleware, middleware)
+
+	return nil
+}
+
+// applyOutboundMiddleware runs the registered chain over the message, in
+// registration order, and returns the message to actually send.
+func (p *tenEnv) applyOutboundMiddleware(m Msg) (Msg, error) {
+	p.middlewareMu.Lock()
+	chain := p.outboundMiddleware
+	p.middlewareMu.Unlock()
+
+	for _, middleware := range chain {
+		out, err := middleware(m)
+		if err != nil {
+			return nil, err
+		}
+		if out != nil {
+			m = out
+		}
+	}
+
+	return m, nil
+}
+
+// outboundCmd applies the middleware chain to a cmd, enforcing that the
+// chain did not swap in a message of another kind.
+func (p *tenEnv) outboundCmd(cmd Cmd) (Cmd, error) {
+	m, err := p.applyOutboundMiddleware(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	out, ok := m.(Cmd)
+	if !ok {
+		return nil, NewTenError(
+			ErrorCodeInvalidType,
+			"outbound middleware must return a cmd for a cmd send.",
+		)
+	}
+	return out, nil
+}
+
+func (p *tenEnv) outboundData(data Data) (Data, error) {
+	m, err := p.applyOutboundMiddleware(data)
+	if err != nil {
+		return nil, err
+	}
+
+	out, ok := m.(Data)
+	if !ok {
+		return nil, NewTenError(
+			ErrorCodeInvalidType,
+			"outbound middleware must return a data for a data send.",
+		)
+	}
+	return out, nil
+}
+
+func (p *tenEnv) outboundVideoFrame(
+	videoFrame VideoFrame,
+) (VideoFrame, error) {
+	m, err := p.applyOutboundMiddleware(videoFrame)
+	if err != nil {
+		return nil, err
+	}
+
+	out, ok := m.(VideoFrame)
+	if !ok {
+		return nil, NewTenError(
+			ErrorCodeInvalidType,
+			"outbound middleware must return a video frame for a video frame send.",
+		)
+	}
+	return out, nil
+}
+
+func (p *tenEnv) outboundAudioFrame(
+	audioFrame AudioFrame,
+) (AudioFrame, error) {
+	m, err := p.applyOutboundMiddleware(audioFrame)
+	if err != nil {
+		return nil, err
+	}
+
+	out, ok := m.(AudioFrame)
+	if !ok {
+		return nil, NewTenError(
+			ErrorCodeInvalidType,
+			"outbound middleware must return an audio frame for an audio frame send.",
+		)
+	}
+	return out, nil
+}
@@ -0,0 +1,152 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+// InboundMiddleware sees every message delivered to the extension before its
+// OnCmd/OnData/OnVideoFrame/OnAudioFrame callback runs, for generic
+// rate-limiting, auth, filtering, or one-place logging at the extension edge.
+// It may mutate the message in place or return a replacement of the same
+// kind; the returned bool decides delivery: false drops the message and the
+// extension callback never runs.
+//
+// Dropping a cmd leaves its sender without a result; a middleware that drops
+// cmds (e.g. a rate limiter) should return an error result itself before
+// returning false.
+type InboundMiddleware func(msg Msg) (Msg, bool)
+
+// UseInboundMiddleware registers middleware applied to every subsequently
+// received message on this ten env. Middlewares run in registration order;
+// each sees the message as left by the previous one, and the first to return
+// false stops the chain and drops the message.
+func (p *tenEnv) UseInboundMiddleware(middleware InboundMiddleware) error {
+	if middleware == nil {
+		return NewTenError(
+			ErrorCodeInvalidArgument,
+			"middleware is required.",
+		)
+	}
+
+	p.middlewareMu.Lock()
+	defer p.middlewareMu.Unlock()
+	p.inboundMiddleware = append(p.inboundMiddleware, middleware)
+
+	return nil
+}
+
+// applyInboundMiddleware runs the registered chain over the message and
+// reports whether it should still be delivered.
+func (p *tenEnv) applyInboundMiddleware(m Msg) (Msg, bool) {
+	p.middlewareMu.Lock()
+	chain := p.inboundMiddleware
+	p.middlewareMu.Unlock()
+
+	for _, middleware := range chain {
+		out, keep := middleware(m)
+		if !keep {
+			return nil, false
+		}
+		if out != nil {
+			m = out
+		}
+	}
+
+	return m, true
+}
+
+// inboundCmd filters a received cmd through the inbound middleware chain. A
+// middleware that swaps in a message of another kind is a bug; the message
+// is dropped with an error log rather than delivered under the wrong type.
+func inboundCmd(tenEnvObj TenEnv, cmd Cmd) (Cmd, bool) {
+	env, ok := tenEnvObj.(*tenEnv)
+	if !ok {
+		return cmd, true
+	}
+
+	m, keep := env.applyInboundMiddleware(cmd)
+	if !keep {
+		return nil, false
+	}
+
+	out, ok := m.(Cmd)
+	if !ok {
+		env.LogError(
+			"An inbound middleware replaced a cmd with another message kind; dropping the message.",
+		)
+		return nil, false
+	}
+	return out, true
+}
+
+func inboundData(tenEnvObj TenEnv, data Data) (Data, bool) {
+	env, ok := tenEnvObj.(*tenEnv)
+	if !ok {
+		return data, true
+	}
+
+	m, keep := env.applyInboundMiddleware(data)
+	if !keep {
+		return nil, false
+	}
+
+	out, ok := m.(Data)
+	if !ok {
+		env.LogError(
+			"An inbound middleware replaced a data with another message kind; dropping the message.",
+		)
+		return nil, false
+	}
+	return out, true
+}
+
+func inboundVideoFrame(
+	tenEnvObj TenEnv,
+	videoFrame VideoFrame,
+) (VideoFrame, bool) {
+	env, ok := tenEnvObj.(*tenEnv)
+	if !ok {
+		return videoFrame, true
+	}
+
+	m, keep := env.applyInboundMiddleware(videoFrame)
+	if !keep {
+		return nil, false
+	}
+
+	out, ok := m.(VideoFrame)
+	if !ok {
+		env.LogError(
+			"An inbound middleware replaced a video frame with another message kind; dropping the message.",
+		)
+		return nil, false
+	}
+	return out, true
+}
+
+func inboundAudioFrame(
+	tenEnvObj TenEnv,
+	audioFrame AudioFrame,
+) (AudioFrame, bool) {
+	env, ok := tenEnvObj.(*tenEnv)
+	if !ok {
+		return audioFrame, true
+	}
+
+	m, keep := env.applyInboundMiddleware(audioFrame)
+	if !keep {
+		return nil, false
+	}
+
+	out, ok := m.(AudioFrame)
+	if !ok {
+		env.LogError(
+			"An inbound middleware replaced an audio frame with another message kind; dropping the message.",
+		)
+		return nil, false
+	}
+	return out, true
+}
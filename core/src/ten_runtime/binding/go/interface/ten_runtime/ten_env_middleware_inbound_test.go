@@ -0,0 +1,70 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import "testing"
+
+func TestInboundMiddlewareRunsInOrder(t *testing.T) {
+	env := &tenEnv{}
+
+	var order []int
+	for i := 0; i < 3; i++ {
+		i := i
+		if err := env.UseInboundMiddleware(
+			func(m Msg) (Msg, bool) {
+				order = append(order, i)
+				return m, true
+			},
+		); err != nil {
+			t.FailNow()
+		}
+	}
+
+	in := &cmd{}
+	out, keep := env.applyInboundMiddleware(in)
+	if !keep || out != Msg(in) {
+		t.FailNow()
+	}
+
+	if len(order) != 3 || order[0] != 0 || order[1] != 1 || order[2] != 2 {
+		t.FailNow()
+	}
+}
+
+func TestInboundMiddlewareDropStopsChain(t *testing.T) {
+	env := &tenEnv{}
+
+	var reached bool
+	if err := env.UseInboundMiddleware(
+		func(m Msg) (Msg, bool) { return nil, false },
+	); err != nil {
+		t.FailNow()
+	}
+	if err := env.UseInboundMiddleware(
+		func(m Msg) (Msg, bool) {
+			reached = true
+			return m, true
+		},
+	); err != nil {
+		t.FailNow()
+	}
+
+	if _, keep := env.applyInboundMiddleware(&cmd{}); keep {
+		t.FailNow()
+	}
+	if reached {
+		t.FailNow()
+	}
+}
+
+func TestUseInboundMiddlewareRejectsNil(t *testing.T) {
+	env := &tenEnv{}
+	if err := env.UseInboundMiddleware(nil); err == nil {
+		t.FailNow()
+	}
+}
@@ -0,0 +1,91 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestOutboundMiddlewareRunsInOrder(t *testing.T) {
+	env := &tenEnv{}
+
+	var order []int
+	for i := 0; i < 3; i++ {
+		i := i
+		if err := env.UseOutboundMiddleware(
+			func(m Msg) (Msg, error) {
+				order = append(order, i)
+				return m, nil
+			},
+		); err != nil {
+			t.FailNow()
+		}
+	}
+
+	in := &cmd{}
+	out, err := env.applyOutboundMiddleware(in)
+	if err != nil || out != Msg(in) {
+		t.FailNow()
+	}
+
+	if len(order) != 3 || order[0] != 0 || order[1] != 1 || order[2] != 2 {
+		t.FailNow()
+	}
+}
+
+func TestOutboundMiddlewareErrorAborts(t *testing.T) {
+	env := &tenEnv{}
+
+	boom := errors.New("boom")
+	var reached bool
+
+	if err := env.UseOutboundMiddleware(
+		func(m Msg) (Msg, error) { return nil, boom },
+	); err != nil {
+		t.FailNow()
+	}
+	if err := env.UseOutboundMiddleware(
+		func(m Msg) (Msg, error) {
+			reached = true
+			return m, nil
+		},
+	); err != nil {
+		t.FailNow()
+	}
+
+	if _, err := env.applyOutboundMiddleware(&cmd{}); err != boom {
+		t.FailNow()
+	}
+	if reached {
+		t.FailNow()
+	}
+}
+
+func TestOutboundMiddlewareKindMismatch(t *testing.T) {
+	env := &tenEnv{}
+
+	// A middleware that swaps a cmd for a data message must be rejected by
+	// the cmd send path.
+	if err := env.UseOutboundMiddleware(
+		func(m Msg) (Msg, error) { return &data{}, nil },
+	); err != nil {
+		t.FailNow()
+	}
+
+	if _, err := env.outboundCmd(&cmd{}); err == nil {
+		t.FailNow()
+	}
+}
+
+func TestUseOutboundMiddlewareRejectsNil(t *testing.T) {
+	env := &tenEnv{}
+	if err := env.UseOutboundMiddleware(nil); err == nil {
+		t.FailNow()
+	}
+}
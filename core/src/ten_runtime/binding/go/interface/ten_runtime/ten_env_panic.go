@@ -0,0 +1,64 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import "runtime/debug"
+
+// PanicHandler receives a panic recovered from one of the extension's
+// callbacks, together with the goroutine stack at the point of the panic, so
+// the extension can log to its own sink. Returning normally continues the
+// process with the callback abandoned; re-panicking from the handler aborts
+// as if no handler were registered.
+type PanicHandler func(recovered any, stack []byte)
+
+// SetPanicHandler registers a handler for panics escaping this extension's
+// callbacks (OnInit, OnCmd, OnData, ...). Register it in OnConfigure — the
+// first callback — to cover the rest of the lifecycle; a panic before
+// registration propagates unchanged, as does any panic when no handler is
+// set, so production deployments can turn panics into controlled failures
+// without changing the default behavior.
+func (p *tenEnv) SetPanicHandler(handler PanicHandler) error {
+	p.panicMu.Lock()
+	defer p.panicMu.Unlock()
+
+	p.panicHandler = handler
+	return nil
+}
+
+func (p *tenEnv) getPanicHandler() PanicHandler {
+	p.panicMu.Lock()
+	defer p.panicMu.Unlock()
+
+	return p.panicHandler
+}
+
+// guardExtensionCallback invokes fn and routes an escaping panic to the
+// panic handler registered on the extension's ten env. Without a registered
+// handler the panic propagates unchanged.
+func guardExtensionCallback(tenEnvObj TenEnv, fn func()) {
+	te, _ := tenEnvObj.(*tenEnv)
+
+	defer func() {
+		recovered := recover()
+		if recovered == nil {
+			return
+		}
+
+		var handler PanicHandler
+		if te != nil {
+			handler = te.getPanicHandler()
+		}
+		if handler == nil {
+			panic(recovered)
+		}
+
+		handler(recovered, debug.Stack())
+	}()
+
+	fn()
+}
@@ -0,0 +1,60 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"testing"
+)
+
+func TestGuardExtensionCallbackRoutesPanicToHandler(t *testing.T) {
+	env := &tenEnv{}
+
+	var recovered any
+	var stack []byte
+	if err := env.SetPanicHandler(func(r any, s []byte) {
+		recovered = r
+		stack = s
+	}); err != nil {
+		t.FailNow()
+	}
+
+	guardExtensionCallback(env, func() {
+		panic("boom")
+	})
+
+	if recovered != "boom" || len(stack) == 0 {
+		t.FailNow()
+	}
+}
+
+func TestGuardExtensionCallbackPropagatesWithoutHandler(t *testing.T) {
+	env := &tenEnv{}
+
+	defer func() {
+		if recover() == nil {
+			t.FailNow()
+		}
+	}()
+
+	guardExtensionCallback(env, func() {
+		panic("boom")
+	})
+}
+
+func TestGuardExtensionCallbackNormalPath(t *testing.T) {
+	env := &tenEnv{}
+
+	called := false
+	guardExtensionCallback(env, func() {
+		called = true
+	})
+
+	if !called {
+		t.FailNow()
+	}
+}
@@ -0,0 +1,94 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import "fmt"
+
+// Pending result handlers are the result callbacks handed to SendCmd /
+// SendCmdEx / SendCmdExCtx whose (final) result has not arrived yet. The ten
+// env keeps an authoritative registry of them so a "command sent but no
+// result" hang can be diagnosed from the runtime instead of from manual
+// bookkeeping in the client. The cost per tracked send is one mutex-guarded
+// map operation, which is negligible next to the cgo crossing itself, so the
+// registry is always on.
+
+// OrphanedResultHandler is notified from OnStopDone for each result handler
+// that is still pending when the extension stops, with the name of the cmd it
+// was registered for.
+type OrphanedResultHandler func(cmdName string)
+
+// trackPendingResult records a result-handler handle as awaiting its final
+// result. It is keyed by the same goHandle that travels through C, so the
+// completion path in tenGoOnCmdResult can clear it. The cmd name is resolved
+// by the caller before it enters the cgo limiter — resolving it here would
+// re-enter the limiter from inside a held slot and deadlock under load.
+func (p *tenEnv) trackPendingResult(cb goHandle, cmdName string) {
+	p.pendingResultsMu.Lock()
+	defer p.pendingResultsMu.Unlock()
+
+	if p.pendingResults == nil {
+		p.pendingResults = make(map[goHandle]string)
+	}
+
+	p.pendingResults[cb] = cmdName
+}
+
+// completePendingResult clears a handle once its final result has been
+// delivered, or once the send itself failed and the handler will never fire.
+func (p *tenEnv) completePendingResult(cb goHandle) {
+	p.pendingResultsMu.Lock()
+	defer p.pendingResultsMu.Unlock()
+
+	delete(p.pendingResults, cb)
+}
+
+// PendingResultCount reports how many result handlers are still waiting for
+// their final result. A count that never drains back to zero points at a
+// responder that does not return a result, or returns only streaming
+// (non-final) ones.
+func (p *tenEnv) PendingResultCount() int {
+	p.pendingResultsMu.Lock()
+	defer p.pendingResultsMu.Unlock()
+
+	return len(p.pendingResults)
+}
+
+// SetOrphanedResultHandler installs a callback invoked from OnStopDone once
+// per result handler that is still pending at that point. Passing nil
+// restores the default, which logs a warning per orphan. The callback runs on
+// the goroutine calling OnStopDone.
+func (p *tenEnv) SetOrphanedResultHandler(handler OrphanedResultHandler) {
+	p.pendingResultsMu.Lock()
+	defer p.pendingResultsMu.Unlock()
+
+	p.orphanedResultHandler = handler
+}
+
+// reportOrphanedResults surfaces the handlers still pending at stop time. The
+// entries stay registered: a late result may still arrive while the ten env
+// is alive, and dropping them here would strand that delivery.
+func (p *tenEnv) reportOrphanedResults() {
+	p.pendingResultsMu.Lock()
+	handler := p.orphanedResultHandler
+	orphans := make([]string, 0, len(p.pendingResults))
+	for _, cmdName := range p.pendingResults {
+		orphans = append(orphans, cmdName)
+	}
+	p.pendingResultsMu.Unlock()
+
+	for _, cmdName := range orphans {
+		if handler != nil {
+			handler(cmdName)
+		} else {
+			p.LogWarn(fmt.Sprintf(
+				"The result handler for cmd %q is still pending at OnStop.",
+				cmdName,
+			))
+		}
+	}
+}
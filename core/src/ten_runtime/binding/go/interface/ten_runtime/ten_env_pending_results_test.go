@@ -0,0 +1,79 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestPendingResultCount(t *testing.T) {
+	env := &tenEnv{}
+
+	if env.PendingResultCount() != 0 {
+		t.FailNow()
+	}
+
+	env.trackPendingResult(1, "first")
+	env.trackPendingResult(2, "second")
+	if env.PendingResultCount() != 2 {
+		t.FailNow()
+	}
+
+	env.completePendingResult(1)
+	if env.PendingResultCount() != 1 {
+		t.FailNow()
+	}
+
+	// Completing an unknown handle is a no-op.
+	env.completePendingResult(99)
+	if env.PendingResultCount() != 1 {
+		t.FailNow()
+	}
+}
+
+func TestReportOrphanedResults(t *testing.T) {
+	env := &tenEnv{}
+
+	var orphans []string
+	env.SetOrphanedResultHandler(func(cmdName string) {
+		orphans = append(orphans, cmdName)
+	})
+
+	env.trackPendingResult(1, "first")
+	env.trackPendingResult(2, "second")
+	env.completePendingResult(2)
+
+	env.reportOrphanedResults()
+	if len(orphans) != 1 || orphans[0] != "first" {
+		t.FailNow()
+	}
+
+	// The orphans stay registered; a late result may still arrive.
+	if env.PendingResultCount() != 1 {
+		t.FailNow()
+	}
+}
+
+func TestReportOrphanedResultsAll(t *testing.T) {
+	env := &tenEnv{}
+
+	var orphans []string
+	env.SetOrphanedResultHandler(func(cmdName string) {
+		orphans = append(orphans, cmdName)
+	})
+
+	env.trackPendingResult(1, "a")
+	env.trackPendingResult(2, "b")
+
+	env.reportOrphanedResults()
+	sort.Strings(orphans)
+	if len(orphans) != 2 || orphans[0] != "a" || orphans[1] != "b" {
+		t.FailNow()
+	}
+}
@@ -1047,7 +1047,29 @@ func (p *tenEnv) InitPropertyFromJSONBytes(value []byte) error {
 		unsafe.Pointer(unsafe.SliceData(value)),
 		C.int(len(value)),
 	)
-	err := withCGoError(&apiStatus)
+	return withCGoError(&apiStatus)
+}
 
-	return err
+func (p *tenEnv) GetPropertyType(path string) (ValueType, error) {
+	if len(path) == 0 {
+		return valueTypeInvalid, NewTenError(
+			ErrorCodeInvalidArgument,
+			"property path is required",
+		)
+	}
+
+	var cValue C.uintptr_t = 0
+	var pSize propSizeInC = 0
+	pt, err := p.getPropertyTypeAndSize(path, &pSize, &cValue)
+	if err != nil {
+		return valueTypeInvalid, err
+	}
+
+	// Only the type is of interest; release the C value retrieved alongside
+	// it.
+	if cValue != 0 {
+		tenCValueDestroy(cValue)
+	}
+
+	return pt.toValueType(), nil
 }
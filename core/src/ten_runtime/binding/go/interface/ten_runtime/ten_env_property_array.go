@@ -0,0 +1,175 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// The array property accessors are built on top of the json property support in
+// TEN runtime, as propTypeArray is not supported as a concrete type in the
+// golang binding. The array is stored as a json array, so it can interoperate
+// with SetPropertyFromJSONBytes/GetPropertyToJSONBytes and with the other
+// language bindings.
+
+// decodePropertyNumberArray parses a json array and validates that every
+// element is a number, reporting the offending index on mismatch.
+func decodePropertyNumberArray(jsonBytes []byte) ([]json.Number, error) {
+	decoder := json.NewDecoder(bytes.NewReader(jsonBytes))
+
+	// Use json.Number to keep the full int64 precision, as plain Unmarshal
+	// parses every number into a float64.
+	decoder.UseNumber()
+
+	var elements []any
+	if err := decoder.Decode(&elements); err != nil {
+		return nil, NewTenError(
+			ErrorCodeInvalidType,
+			fmt.Sprintf("expected: array, actual: %s", jsonBytes),
+		)
+	}
+
+	numbers := make([]json.Number, len(elements))
+	for i, element := range elements {
+		number, ok := element.(json.Number)
+		if !ok {
+			return nil, NewTenError(
+				ErrorCodeInvalidType,
+				fmt.Sprintf(
+					"expected: number at index %d, actual: %v",
+					i,
+					element,
+				),
+			)
+		}
+
+		numbers[i] = number
+	}
+
+	return numbers, nil
+}
+
+// GetPropertyInt64Array retrieves an array property whose elements are all
+// int64. An error carrying the offending index is returned if any element is
+// not an integer.
+func (p *tenEnv) GetPropertyInt64Array(path string) ([]int64, error) {
+	if len(path) == 0 {
+		return nil, NewTenError(
+			ErrorCodeInvalidArgument,
+			"property path is required",
+		)
+	}
+
+	jsonBytes, err := p.GetPropertyToJSONBytes(path)
+	if err != nil {
+		return nil, err
+	}
+	defer ReleaseBytes(jsonBytes)
+
+	numbers, err := decodePropertyNumberArray(jsonBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]int64, len(numbers))
+	for i, number := range numbers {
+		value, err := number.Int64()
+		if err != nil {
+			return nil, NewTenError(
+				ErrorCodeInvalidType,
+				fmt.Sprintf(
+					"expected: int64 at index %d, actual: %s",
+					i,
+					number,
+				),
+			)
+		}
+
+		values[i] = value
+	}
+
+	return values, nil
+}
+
+// GetPropertyFloat64Array retrieves an array property whose elements are all
+// numbers, converted to float64. An error carrying the offending index is
+// returned if any element is not a number.
+func (p *tenEnv) GetPropertyFloat64Array(path string) ([]float64, error) {
+	if len(path) == 0 {
+		return nil, NewTenError(
+			ErrorCodeInvalidArgument,
+			"property path is required",
+		)
+	}
+
+	jsonBytes, err := p.GetPropertyToJSONBytes(path)
+	if err != nil {
+		return nil, err
+	}
+	defer ReleaseBytes(jsonBytes)
+
+	numbers, err := decodePropertyNumberArray(jsonBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]float64, len(numbers))
+	for i, number := range numbers {
+		value, err := number.Float64()
+		if err != nil {
+			return nil, NewTenError(
+				ErrorCodeInvalidType,
+				fmt.Sprintf(
+					"expected: float64 at index %d, actual: %s",
+					i,
+					number,
+				),
+			)
+		}
+
+		values[i] = value
+	}
+
+	return values, nil
+}
+
+// SetPropertyInt64Array sets an []int64 as an array property in the ten.
+func (p *tenEnv) SetPropertyInt64Array(path string, value []int64) error {
+	if len(path) == 0 {
+		return NewTenError(
+			ErrorCodeInvalidArgument,
+			"the property path is required",
+		)
+	}
+
+	jsonBytes, err := json.Marshal(value)
+	if err != nil {
+		return NewTenError(ErrorCodeInvalidJSON, err.Error())
+	}
+
+	return p.SetPropertyFromJSONBytes(path, jsonBytes)
+}
+
+// SetPropertyFloat64Array sets a []float64 as an array property in the ten.
+func (p *tenEnv) SetPropertyFloat64Array(path string, value []float64) error {
+	if len(path) == 0 {
+		return NewTenError(
+			ErrorCodeInvalidArgument,
+			"the property path is required",
+		)
+	}
+
+	jsonBytes, err := json.Marshal(value)
+	if err != nil {
+		return NewTenError(ErrorCodeInvalidJSON, err.Error())
+	}
+
+	return p.SetPropertyFromJSONBytes(path, jsonBytes)
+}
@@ -0,0 +1,47 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDecodePropertyNumberArray(t *testing.T) {
+	numbers, err := decodePropertyNumberArray([]byte("[1, 2, 3]"))
+	if err != nil {
+		t.FailNow()
+	}
+
+	if len(numbers) != 3 {
+		t.FailNow()
+	}
+
+	v, err := numbers[2].Int64()
+	if err != nil || v != 3 {
+		t.FailNow()
+	}
+}
+
+func TestDecodePropertyNumberArrayNotAnArray(t *testing.T) {
+	_, err := decodePropertyNumberArray([]byte(`"not an array"`))
+	if err == nil {
+		t.FailNow()
+	}
+}
+
+func TestDecodePropertyNumberArrayOffendingIndex(t *testing.T) {
+	_, err := decodePropertyNumberArray([]byte(`[1, "two", 3]`))
+	if err == nil {
+		t.FailNow()
+	}
+
+	if !strings.Contains(err.Error(), "index 1") {
+		t.FailNow()
+	}
+}
@@ -0,0 +1,42 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import "sort"
+
+// applyPropertyOverrides applies the overrides in ascending path order, so a
+// parent path is always written before any of its children.
+func applyPropertyOverrides(
+	setProperty func(path string, value any) error,
+	overrides map[string]any,
+) error {
+	paths := make([]string, 0, len(overrides))
+	for path := range overrides {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		if err := setProperty(path, overrides[path]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *tenEnv) InitPropertyFromJSONBytesWithOverrides(
+	value []byte,
+	overrides map[string]any,
+) error {
+	if err := p.InitPropertyFromJSONBytes(value); err != nil {
+		return err
+	}
+
+	return applyPropertyOverrides(p.SetProperty, overrides)
+}
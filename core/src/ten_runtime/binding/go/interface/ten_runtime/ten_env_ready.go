@@ -0,0 +1,115 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+// #include <stdlib.h>
+// #include "ten_env.h"
+import "C"
+
+import (
+	"sync"
+	"unsafe"
+)
+
+// extensionReadiness tracks, per extension instance, whether the extension
+// has declared itself ready to serve. It is keyed by the ten env so
+// same-named extensions in different graphs can not overwrite each other's
+// state; the entry is dropped when the extension is deinitialized. An
+// extension that never calls SetReady does not appear here, and is treated
+// as ready for backward compatibility.
+var extensionReadiness struct {
+	sync.Mutex
+	states map[*tenEnv]extensionReadyState
+}
+
+type extensionReadyState struct {
+	name  string
+	ready bool
+}
+
+// SetReady declares whether the extension this ten env is attached to is
+// ready to serve. Extensions that depend on external services (ex: a model
+// API) should call SetReady(false) in OnStart and SetReady(true) once the
+// dependency is connected, so the app-level aggregation can gate traffic
+// until every extension is truly ready.
+func (p *tenEnv) SetReady(ready bool) error {
+	defer p.keepAlive()
+
+	cName, _ := withCGOLimiterHasReturnValue[*C.char](func() (*C.char, error) {
+		return C.ten_go_ten_env_get_attached_extension_name(p.cPtr), nil
+	})
+	if cName == nil {
+		return NewTenError(
+			ErrorCodeGeneric,
+			"SetReady is only supported on a ten env attached to an extension.",
+		)
+	}
+
+	name := C.GoString(cName)
+	C.free(unsafe.Pointer(cName))
+
+	extensionReadiness.Lock()
+	defer extensionReadiness.Unlock()
+
+	if extensionReadiness.states == nil {
+		extensionReadiness.states = make(map[*tenEnv]extensionReadyState)
+	}
+	extensionReadiness.states[p] = extensionReadyState{
+		name:  name,
+		ready: ready,
+	}
+
+	return nil
+}
+
+// clearReadiness drops this instance's readiness declaration. It is invoked
+// from the extension's deinit path, so an extension destroyed while not
+// ready can not poison AllExtensionsReady for the rest of the process.
+func (p *tenEnv) clearReadiness() {
+	extensionReadiness.Lock()
+	defer extensionReadiness.Unlock()
+
+	delete(extensionReadiness.states, p)
+}
+
+// ExtensionReadyStates returns a snapshot of the readiness declared via
+// SetReady, keyed by extension name. Extensions that never called SetReady
+// are absent; when several live instances share a name, the name reads ready
+// only if all of them are.
+func ExtensionReadyStates() map[string]bool {
+	extensionReadiness.Lock()
+	defer extensionReadiness.Unlock()
+
+	states := make(map[string]bool, len(extensionReadiness.states))
+	for _, state := range extensionReadiness.states {
+		if ready, ok := states[state.name]; ok {
+			states[state.name] = ready && state.ready
+		} else {
+			states[state.name] = state.ready
+		}
+	}
+
+	return states
+}
+
+// AllExtensionsReady reports whether every live extension that declared
+// readiness via SetReady is currently ready. A health endpoint of the app can
+// expose this directly so orchestration holds traffic until the whole graph
+// is ready.
+func AllExtensionsReady() bool {
+	extensionReadiness.Lock()
+	defer extensionReadiness.Unlock()
+
+	for _, state := range extensionReadiness.states {
+		if !state.ready {
+			return false
+		}
+	}
+
+	return true
+}
@@ -10,6 +10,15 @@ package ten_runtime
 // #include "ten_env.h"
 import "C"
 
+// ErrResultAlreadyReturned is returned by ReturnResult when a final result
+// has already been returned for the command the result responds to. Returning
+// twice is almost always a bug in the extension; rejecting the second call
+// keeps the runtime state intact and surfaces the bug early.
+var ErrResultAlreadyReturned = NewTenError(
+	ErrorCodeGeneric,
+	"a final result has already been returned for this cmd",
+)
+
 func (p *tenEnv) ReturnResult(
 	statusCmd CmdResult,
 	handler ErrorHandler,
@@ -26,6 +35,46 @@ func (p *tenEnv) ReturnResult(
 		statusCmd.keepAlive()
 	}()
 
+	// Reject a second return for the same command. Multiple non-final
+	// (streaming) results are still allowed; only a result after a final one
+	// is a double return.
+	var target *cmd
+	if res, ok := statusCmd.(*cmdResult); ok {
+		target = res.targetCmd
+	}
+
+	if target != nil && target.resultReturned.Load() {
+		return ErrResultAlreadyReturned
+	}
+
+	isFinal, finalErr := statusCmd.IsFinal()
+
+	// Read the cancel id (if any) before the C call, as the runtime may
+	// consume the cmd when the final result is returned.
+	cancelID := ""
+	if target != nil {
+		cancelID, _ = target.GetPropertyString(CancelIDPropertyName)
+	}
+
+	// Stamp the send order on the result so receivers of a streamed result
+	// sequence can detect reordering. See ResultSequencePropertyName.
+	if target != nil {
+		if seqErr := statusCmd.SetProperty(
+			ResultSequencePropertyName,
+			target.nextResultSeq(),
+		); seqErr != nil {
+			return seqErr
+		}
+	}
+
+	// Carry the command's timeline over to the result and stamp the return
+	// time, so the sender can separate queueing delay from handler time.
+	// See EnableCmdTimings.
+	if target != nil {
+		propagateCmdTimings(target, statusCmd)
+	}
+	stampCmdTiming(statusCmd, timingReturned)
+
 	cb := goHandleNil
 	if handler != nil {
 		cb = newGoHandle(handler)
@@ -43,7 +92,20 @@ func (p *tenEnv) ReturnResult(
 	if err != nil {
 		// Clean up the handle if there was an error.
 		loadAndDeleteGoHandle(cb)
+		return err
+	}
+
+	if target != nil && (finalErr != nil || isFinal) {
+		// Treat a result whose final flag can not be read as final, which is
+		// the default of the runtime.
+		target.resultReturned.Store(true)
+
+		// The stream is over; drop any pending cancel signal so the tracking
+		// map stays bounded.
+		if cancelID != "" {
+			clearCmdCanceled(cancelID)
+		}
 	}
 
-	return err
+	return nil
 }
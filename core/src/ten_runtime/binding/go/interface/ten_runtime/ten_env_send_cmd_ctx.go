@@ -0,0 +1,78 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+// #include "ten_env.h"
+import "C"
+
+// resultHandlerWithCtx bundles a ResultHandlerWithCtx with its user data in
+// the handle map, so tenGoOnCmdResult can deliver both to the callback.
+type resultHandlerWithCtx struct {
+	handler  ResultHandlerWithCtx
+	userData any
+}
+
+func (p *tenEnv) SendCmdExCtx(
+	cmd Cmd,
+	userData any,
+	handler ResultHandlerWithCtx,
+) error {
+	if cmd == nil {
+		return NewTenError(
+			ErrorCodeInvalidArgument,
+			"cmd is required.",
+		)
+	}
+
+	// The same outbound pipeline as SendCmd/SendCmdEx: middleware, limits,
+	// timing stamp — a ctx-carrying send is not exempt from any of them.
+	cmd, err := p.outboundCmd(cmd)
+	if err != nil {
+		return err
+	}
+
+	if err := checkMessageLimits(cmd); err != nil {
+		return err
+	}
+
+	stampCmdTiming(cmd, timingEnqueue)
+
+	// Resolved before entering the limiter; GetName is itself a cgo call.
+	cmdName := ""
+	if handler != nil {
+		cmdName, _ = cmd.GetName()
+	}
+
+	return withCGOLimiter(func() error {
+		defer cmd.keepAlive()
+
+		cb := goHandleNil
+		if handler != nil {
+			cb = newGoHandle(&resultHandlerWithCtx{
+				handler:  handler,
+				userData: userData,
+			})
+			p.trackPendingResult(cb, cmdName)
+		}
+
+		cStatus := C.ten_go_ten_env_send_cmd(
+			p.cPtr,
+			cmd.getCPtr(),
+			cHandle(cb),
+			C.bool(true),
+		)
+
+		err := withCGoError(&cStatus)
+		if err != nil && cb != goHandleNil {
+			// The send failed synchronously; the handler will never fire.
+			p.completePendingResult(cb)
+		}
+
+		return err
+	})
+}
@@ -0,0 +1,54 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+func (p *tenEnv) SendCmdFinal(cmd Cmd) (CmdResult, error) {
+	if cmd == nil {
+		return nil, NewTenError(
+			ErrorCodeInvalidArgument,
+			"cmd is required.",
+		)
+	}
+
+	type outcome struct {
+		result CmdResult
+		err    error
+	}
+
+	done := make(chan outcome, 1)
+
+	err := p.SendCmdEx(cmd, func(te TenEnv, result CmdResult, err error) {
+		if err != nil {
+			done <- outcome{err: err}
+			return
+		}
+
+		if result == nil {
+			return
+		}
+
+		completed, err := result.IsCompleted()
+		if err != nil {
+			done <- outcome{err: err}
+			return
+		}
+
+		if !completed {
+			// Intermediate streaming results are discarded.
+			return
+		}
+
+		done <- outcome{result: result}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	o := <-done
+	return o.result, o.err
+}
@@ -0,0 +1,147 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"errors"
+	"time"
+)
+
+// RetryPolicy controls how SendCmdRetry retries a command after a transient
+// send failure.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first send.
+	// Values below 1 are treated as 1.
+	MaxAttempts int
+
+	// Backoff is the delay before the first retry.
+	Backoff time.Duration
+
+	// BackoffFactor multiplies the backoff after every retry. Values at or
+	// below 1 keep the backoff constant.
+	BackoffFactor float64
+}
+
+// isTransientSendError reports whether a send failure is worth retrying.
+// Routing failures (the msg is not connected, which happens during graph
+// reconfiguration) and timeouts are transient; everything else, including an
+// error CmdResult genuinely produced by the destination, is permanent.
+func isTransientSendError(err error) bool {
+	var tenErr *TenError
+	if !errors.As(err, &tenErr) {
+		return false
+	}
+
+	switch tenErr.ErrorCode {
+	case ErrorCodeMsgNotConnected, ErrorCodeTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+func (p *tenEnv) SendCmdRetry(
+	cmd Cmd,
+	policy RetryPolicy,
+	handler ResultHandler,
+) error {
+	if cmd == nil {
+		return NewTenError(
+			ErrorCodeInvalidArgument,
+			"cmd is required.",
+		)
+	}
+
+	if policy.MaxAttempts < 1 {
+		policy.MaxAttempts = 1
+	}
+
+	return p.sendCmdAttempt(cmd, policy, handler, 1, policy.Backoff)
+}
+
+// sendCmdAttempt sends one attempt; the result handler schedules the next
+// attempt on a transient failure. A clone is kept for the next attempt before
+// sending, since a sent cmd is consumed by the runtime.
+func (p *tenEnv) sendCmdAttempt(
+	cmd Cmd,
+	policy RetryPolicy,
+	handler ResultHandler,
+	attempt int,
+	backoff time.Duration,
+) error {
+	toSend := cmd
+	if attempt < policy.MaxAttempts {
+		// Only clone while a retry is still possible; the last attempt can
+		// consume the original.
+		clone, err := cmd.Clone()
+		if err != nil {
+			return err
+		}
+		toSend = cmd
+		cmd = clone
+	}
+
+	sendErr := p.SendCmd(toSend, func(te TenEnv, result CmdResult, err error) {
+		if err != nil &&
+			isTransientSendError(err) &&
+			attempt < policy.MaxAttempts {
+			p.scheduleRetryAttempt(te, cmd, policy, handler, attempt, backoff)
+			return
+		}
+
+		if handler != nil {
+			handler(te, result, err)
+		}
+	})
+
+	// A synchronous failure gets the same transient treatment as one
+	// delivered through the handler; otherwise a routing error raised before
+	// the cmd leaves the extension would bypass the policy entirely. Once a
+	// retry is scheduled the outcome is delivered via the handler, so the
+	// synchronous return is nil.
+	if sendErr != nil &&
+		isTransientSendError(sendErr) &&
+		attempt < policy.MaxAttempts {
+		p.scheduleRetryAttempt(p, cmd, policy, handler, attempt, backoff)
+		return nil
+	}
+
+	return sendErr
+}
+
+// scheduleRetryAttempt runs the next attempt after the backoff, growing it by
+// the policy's factor. A failure to start the retry is delivered to the
+// handler, since the caller of the original send has long returned.
+func (p *tenEnv) scheduleRetryAttempt(
+	te TenEnv,
+	cmd Cmd,
+	policy RetryPolicy,
+	handler ResultHandler,
+	attempt int,
+	backoff time.Duration,
+) {
+	nextBackoff := backoff
+	if policy.BackoffFactor > 1 {
+		nextBackoff = time.Duration(
+			float64(nextBackoff) * policy.BackoffFactor,
+		)
+	}
+
+	time.AfterFunc(backoff, func() {
+		retryErr := p.sendCmdAttempt(
+			cmd,
+			policy,
+			handler,
+			attempt+1,
+			nextBackoff,
+		)
+		if retryErr != nil && handler != nil {
+			handler(te, nil, retryErr)
+		}
+	})
+}
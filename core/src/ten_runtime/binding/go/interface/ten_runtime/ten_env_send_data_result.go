@@ -0,0 +1,86 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import "sync"
+
+// DataDeliveryState tells a sender how far a data message got, since a plain
+// error can not distinguish "still queued" from "handed to the destination".
+type DataDeliveryState uint8
+
+const (
+	// DataQueued means the runtime accepted the data and queued it for
+	// routing; it has not reached the destination extension yet.
+	DataQueued DataDeliveryState = iota
+
+	// DataDelivered means the runtime finished routing the data to the input
+	// queue of every destination extension. It does not mean the destination
+	// has processed it; the runtime provides no application-level ack.
+	DataDelivered
+
+	// DataFailed means the data could not be delivered, e.g. the graph has no
+	// route for it (ErrorCodeMsgNotConnected) or the runtime is closing
+	// (ErrorCodeTenIsClosed). The error carries the cause.
+	DataFailed
+)
+
+// DataResultHandler receives the delivery-state transitions of one
+// SendDataWithResult call.
+type DataResultHandler func(tenEnv TenEnv, state DataDeliveryState, err error)
+
+// SendDataWithResult sends the data like SendData, but reports delivery
+// progress instead of a bare error. The handler is invoked with DataQueued as
+// soon as the runtime accepts the data, and once more with DataDelivered or
+// DataFailed when routing completes.
+//
+// Note the precise semantics, which also apply to SendData's ErrorHandler: a
+// nil error (DataDelivered) means the runtime routed the data into the
+// destination extensions' input queues — not that a destination processed it.
+// The runtime has no application-level ack and no bounded queue, so a
+// queue-full condition can not occur; sustained backpressure instead shows up
+// as growing delivery latency, which callers can observe by timing the
+// DataQueued-to-DataDelivered gap.
+func (p *tenEnv) SendDataWithResult(
+	data Data,
+	handler DataResultHandler,
+) error {
+	// The terminal callback can fire on the runtime thread before SendData
+	// even returns, so DataQueued is emitted through a sync.Once from
+	// whichever side gets there first — the transitions always arrive in
+	// order, and DataQueued is never reported for a send the runtime
+	// rejected.
+	var queued sync.Once
+	emitQueued := func(tenEnv TenEnv) {
+		queued.Do(func() {
+			handler(tenEnv, DataQueued, nil)
+		})
+	}
+
+	var errorHandler ErrorHandler
+	if handler != nil {
+		errorHandler = func(tenEnv TenEnv, err error) {
+			emitQueued(tenEnv)
+
+			if err != nil {
+				handler(tenEnv, DataFailed, err)
+				return
+			}
+			handler(tenEnv, DataDelivered, nil)
+		}
+	}
+
+	if err := p.SendData(data, errorHandler); err != nil {
+		return err
+	}
+
+	if handler != nil {
+		emitQueued(p)
+	}
+
+	return nil
+}
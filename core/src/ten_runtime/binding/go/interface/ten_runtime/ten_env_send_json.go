@@ -0,0 +1,52 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import "encoding/json"
+
+// SendJSON creates a cmd with the given name, loads its properties from the
+// JSON object in propsJSON, and sends it, collapsing the usual NewCmd +
+// SetProperty* + SendCmd sequence into one call. An empty propsJSON sends the
+// cmd without properties; malformed JSON is rejected before anything is sent.
+func (p *tenEnv) SendJSON(
+	name string,
+	propsJSON string,
+	handler ResultHandler,
+) error {
+	cmd, err := newCmdFromJSON(name, propsJSON)
+	if err != nil {
+		return err
+	}
+
+	return p.SendCmd(cmd, handler)
+}
+
+func newCmdFromJSON(name string, propsJSON string) (Cmd, error) {
+	if propsJSON != "" && !json.Valid([]byte(propsJSON)) {
+		return nil, NewTenError(
+			ErrorCodeInvalidJSON,
+			"propsJSON is not valid JSON.",
+		)
+	}
+
+	cmd, err := NewCmd(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if propsJSON != "" {
+		if err := cmd.SetPropertyFromJSONBytes(
+			"",
+			[]byte(propsJSON),
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	return cmd, nil
+}
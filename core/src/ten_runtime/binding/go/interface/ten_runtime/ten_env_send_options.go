@@ -0,0 +1,65 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+// NoResultPropertyName is the property WithNoResultHint stamps on a cmd so
+// the responder side can see that no result is expected; see
+// CmdExpectsResult.
+const NoResultPropertyName = "ten:no_result"
+
+// sendCmdOptions collects the per-send adjustments applied by SendCmdOption
+// values.
+type sendCmdOptions struct {
+	noResultHint bool
+}
+
+// SendCmdOption adjusts how a single SendCmd call behaves.
+type SendCmdOption func(*sendCmdOptions)
+
+// WithNoResultHint marks the cmd as fire-and-forget on the sender side: the
+// handler passed to SendCmd must be nil, so no result-handling machinery is
+// allocated, which matters for high-volume cmds such as metrics or
+// heartbeats.
+//
+// The hint is advisory. The runtime still routes whatever result the
+// responder returns — it is discarded at the sender, not suppressed in the
+// native send path. A cooperating responder can detect the marker via
+// CmdExpectsResult and skip ReturnResult, which is what actually saves the
+// round trip.
+func WithNoResultHint() SendCmdOption {
+	return func(o *sendCmdOptions) {
+		o.noResultHint = true
+	}
+}
+
+func applySendCmdOptions(options []SendCmdOption) sendCmdOptions {
+	var o sendCmdOptions
+	for _, option := range options {
+		if option != nil {
+			option(&o)
+		}
+	}
+	return o
+}
+
+// CmdExpectsResult reports whether the sender of the cmd expects a result. A
+// responder handling a cmd sent with WithNoResultHint should skip
+// ReturnResult; cmds without the marker expect a result as usual.
+func CmdExpectsResult(cmd Cmd) bool {
+	if cmd == nil {
+		return false
+	}
+
+	noResult, err := cmd.GetPropertyBool(NoResultPropertyName)
+	if err != nil {
+		// The marker is absent on ordinary cmds.
+		return true
+	}
+
+	return !noResult
+}
@@ -0,0 +1,50 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+// #include <stdlib.h>
+// #include "ten_env.h"
+import "C"
+
+import "unsafe"
+
+func (p *tenEnv) SendToSelf(cmd Cmd, handler ResultHandler) error {
+	if cmd == nil {
+		return NewTenError(
+			ErrorCodeInvalidArgument,
+			"cmd is required.",
+		)
+	}
+
+	defer p.keepAlive()
+
+	cName, _ := withCGOLimiterHasReturnValue[*C.char](func() (*C.char, error) {
+		return C.ten_go_ten_env_get_attached_extension_name(p.cPtr), nil
+	})
+	if cName == nil {
+		return NewTenError(
+			ErrorCodeGeneric,
+			"SendToSelf is only supported on a ten env attached to an extension.",
+		)
+	}
+
+	name := C.GoString(cName)
+	C.free(unsafe.Pointer(cName))
+
+	// Route the command back to this extension; the empty app uri and graph
+	// id denote the current app and graph.
+	if err := cmd.SetDests(Loc{
+		AppURI:        Ptr(""),
+		GraphID:       Ptr(""),
+		ExtensionName: Ptr(name),
+	}); err != nil {
+		return err
+	}
+
+	return p.SendCmd(cmd, handler)
+}
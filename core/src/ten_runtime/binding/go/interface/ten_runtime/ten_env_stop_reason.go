@@ -0,0 +1,54 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+// #include "ten_env.h"
+import "C"
+
+import "fmt"
+
+// StopReason tells an extension, inside OnStop, why it is being stopped, so
+// cleanup can be adjusted (ex: an extension uploading recordings might skip
+// the upload when the stop is not a normal one).
+type StopReason int32
+
+// StopReason values. These definitions need to be the same as the values
+// returned by ten_go_ten_env_get_stop_reason in C.
+const (
+	// StopReasonNormal is a normal graph stop while the app keeps running.
+	StopReasonNormal StopReason = iota
+
+	// StopReasonAppClose means the whole app is closing.
+	StopReasonAppClose
+
+	// An error reason is deliberately absent: the runtime does not attribute
+	// a teardown to an unrecoverable error today, and shipping a value the
+	// accessor can never return would be misleading. It can be added once the
+	// C side can produce it.
+)
+
+func (s StopReason) String() string {
+	switch s {
+	case StopReasonNormal:
+		return "normal"
+	case StopReasonAppClose:
+		return "app_close"
+	default:
+		return fmt.Sprintf("invalid(%d)", int32(s))
+	}
+}
+
+func (p *tenEnv) StopReason() StopReason {
+	defer p.keepAlive()
+
+	reason, _ := withCGOLimiterHasReturnValue[int32](func() (int32, error) {
+		return int32(C.ten_go_ten_env_get_stop_reason(p.cPtr)), nil
+	})
+
+	return StopReason(reason)
+}
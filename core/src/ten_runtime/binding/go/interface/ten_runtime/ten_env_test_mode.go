@@ -0,0 +1,15 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+// #include "ten_env.h"
+import "C"
+
+func (p *tenEnv) IsUnderTest() bool {
+	return bool(C.ten_go_ten_env_is_under_test(p.cPtr))
+}
@@ -12,6 +12,7 @@ import "C"
 import (
 	"runtime"
 	"strings"
+	"sync"
 	"unsafe"
 )
 
@@ -31,6 +32,12 @@ type TenEnvTester interface {
 
 	SendCmd(cmd Cmd, handler TesterResultHandler) error
 	SendCmdEx(cmd Cmd, handler TesterResultHandler) error
+
+	// SendCmdStream sends the cmd and returns a channel yielding every
+	// received result in order, closed after the completed result; see the
+	// method doc for the buffering caveat. Pair it with
+	// ExpectStatusSequence to assert the exact sequence of statuses.
+	SendCmdStream(cmd Cmd) (<-chan CmdReply, error)
 	SendData(data Data, handler TesterErrorHandler) error
 	SendAudioFrame(audioFrame AudioFrame, handler TesterErrorHandler) error
 	SendVideoFrame(videoFrame VideoFrame, handler TesterErrorHandler) error
@@ -39,6 +46,70 @@ type TenEnvTester interface {
 
 	StopTest(testResult *TenError) error
 
+	// ArmUpstreamCloseSimulation makes subsequent sends from the tester
+	// cancelable by SimulateUpstreamClose. Tests that do not arm pay no
+	// per-send tracking cost and their cmds carry no cancel id.
+	ArmUpstreamCloseSimulation()
+
+	// SimulateUpstreamClose tears down the logical upstream connection
+	// mid-test: in-flight cmds are marked canceled so the extension's
+	// cleanup path runs, and further sends from the tester fail as if the
+	// client had disconnected. Only cmds sent after
+	// ArmUpstreamCloseSimulation are tracked and canceled.
+	SimulateUpstreamClose() error
+
+	// ExpectInFlightWorkStopped asserts that the extension wound down every
+	// cmd that was in flight when SimulateUpstreamClose was called. A
+	// failure is returned as an error suitable for passing to StopTest.
+	ExpectInFlightWorkStopped() error
+
+	// Done returns a channel that is closed when the test stops (StopTest or
+	// deinit). Background goroutines spawned by an async tester should
+	// select on it, so they stop with the test instead of leaking into the
+	// next one; this is the recommended pattern for long-running work in
+	// OnStart.
+	Done() <-chan struct{}
+
+	// Invocations returns how many times each lifecycle and message handler
+	// of the extension under test has been dispatched so far, keyed by the
+	// handler name (ex: "OnCmd"). Useful to assert that a message actually
+	// reached the intended handler.
+	Invocations() map[string]int
+
+	// ExpectAudioFrameBuf asserts that the received audio frame has the given
+	// name and that its buffer matches `want` byte for byte, within the
+	// optional tolerance. A mismatch is returned as an error suitable for
+	// passing to StopTest.
+	ExpectAudioFrameBuf(
+		audioFrame AudioFrame,
+		name string,
+		want []byte,
+		options ...ExpectOption,
+	) error
+
+	// ExpectData asserts that the received data message has the given name
+	// and that its buffer matches `wantBuf` byte for byte, within the
+	// optional tolerance. A mismatch is returned as an error suitable for
+	// passing to StopTest.
+	ExpectData(
+		data Data,
+		name string,
+		wantBuf []byte,
+		options ...ExpectOption,
+	) error
+
+	// ExpectVideoFrame asserts that the received video frame has the given
+	// name, dimensions and pixel format. A mismatch is returned as an error
+	// suitable for passing to StopTest.
+	ExpectVideoFrame(
+		videoFrame VideoFrame,
+		name string,
+		wantWidth int32,
+		wantHeight int32,
+		wantFmt PixelFmt,
+		options ...ExpectOption,
+	) error
+
 	LogDebug(msg string) error
 	LogInfo(msg string) error
 	LogWarn(msg string) error
@@ -58,6 +129,18 @@ var (
 
 type tenEnvTester struct {
 	baseTenObject[C.uintptr_t]
+
+	invocationsMu sync.Mutex
+	invocations   map[string]int
+
+	doneMu     sync.Mutex
+	done       chan struct{}
+	doneClosed bool
+
+	upstreamMu     sync.Mutex
+	upstreamArmed  bool
+	upstreamClosed bool
+	inFlight       map[string]struct{}
 }
 
 func (p *tenEnvTester) OnStartDone() error {
@@ -68,6 +151,8 @@ func (p *tenEnvTester) OnStartDone() error {
 }
 
 func (p *tenEnvTester) OnStopDone() error {
+	p.recordInvocation("OnStopDone")
+
 	return withCGOLimiter(func() error {
 		cStatus := C.ten_go_ten_env_tester_on_stop_done(p.cPtr)
 		return withCGoError(&cStatus)
@@ -75,6 +160,9 @@ func (p *tenEnvTester) OnStopDone() error {
 }
 
 func (p *tenEnvTester) OnDeinitDone() error {
+	// The test is over either way; release any goroutines waiting on Done.
+	p.closeDone()
+
 	return withCGOLimiter(func() error {
 		cStatus := C.ten_go_ten_env_tester_on_deinit_done(p.cPtr)
 		return withCGoError(&cStatus)
@@ -89,6 +177,11 @@ func (p *tenEnvTester) SendCmd(cmd Cmd, handler TesterResultHandler) error {
 		)
 	}
 
+	handler, err := p.trackOutgoingCmd(cmd, handler)
+	if err != nil {
+		return err
+	}
+
 	return withCGOLimiter(func() error {
 		return p.sendCmd(cmd, handler)
 	})
@@ -102,6 +195,11 @@ func (p *tenEnvTester) SendCmdEx(cmd Cmd, handler TesterResultHandler) error {
 		)
 	}
 
+	handler, err := p.trackOutgoingCmd(cmd, handler)
+	if err != nil {
+		return err
+	}
+
 	return withCGOLimiter(func() error {
 		return p.sendCmdEx(cmd, handler)
 	})
@@ -169,9 +267,40 @@ func (p *tenEnvTester) ReturnResult(
 }
 
 func (p *tenEnvTester) StopTest(testResult *TenError) error {
-	return withCGOLimiter(func() error {
+	err := withCGOLimiter(func() error {
 		return p.stopTest(testResult)
 	})
+	if err == nil {
+		p.closeDone()
+	}
+
+	return err
+}
+
+func (p *tenEnvTester) Done() <-chan struct{} {
+	p.doneMu.Lock()
+	defer p.doneMu.Unlock()
+
+	if p.done == nil {
+		p.done = make(chan struct{})
+	}
+
+	return p.done
+}
+
+// closeDone closes the done channel exactly once.
+func (p *tenEnvTester) closeDone() {
+	p.doneMu.Lock()
+	defer p.doneMu.Unlock()
+
+	if p.done == nil {
+		p.done = make(chan struct{})
+	}
+
+	if !p.doneClosed {
+		close(p.done)
+		p.doneClosed = true
+	}
 }
 
 func (p *tenEnvTester) sendCmd(cmd Cmd, handler TesterResultHandler) error {
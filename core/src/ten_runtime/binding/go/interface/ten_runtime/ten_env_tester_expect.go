@@ -0,0 +1,225 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import "fmt"
+
+// ExpectOption customizes how the Expect* helpers of TenEnvTester compare a
+// received frame against its expectation.
+type ExpectOption func(*expectOptions)
+
+type expectOptions struct {
+	// byteTolerance is the maximum allowed absolute difference per byte when
+	// comparing frame buffers. The default of 0 requires an exact match.
+	byteTolerance uint8
+}
+
+// WithByteTolerance allows each byte of the received buffer to deviate from
+// the expected buffer by at most the given amount. Useful when asserting the
+// output of lossy transforms where a bit-exact comparison is too strict.
+func WithByteTolerance(tolerance uint8) ExpectOption {
+	return func(o *expectOptions) {
+		o.byteTolerance = tolerance
+	}
+}
+
+func applyExpectOptions(options []ExpectOption) expectOptions {
+	var o expectOptions
+	for _, option := range options {
+		option(&o)
+	}
+	return o
+}
+
+// compareFrameBufs compares the received buffer against the expected one,
+// returning a description of the first mismatch, or "" when the buffers match
+// within the tolerance.
+func compareFrameBufs(got []byte, want []byte, tolerance uint8) string {
+	if len(got) != len(want) {
+		return fmt.Sprintf(
+			"buffer length mismatch: got %d bytes, want %d bytes",
+			len(got),
+			len(want),
+		)
+	}
+
+	for i := range want {
+		diff := int(got[i]) - int(want[i])
+		if diff < 0 {
+			diff = -diff
+		}
+		if diff > int(tolerance) {
+			return fmt.Sprintf(
+				"buffer mismatch at byte %d: got 0x%02x, want 0x%02x (tolerance %d)",
+				i,
+				got[i],
+				want[i],
+				tolerance,
+			)
+		}
+	}
+
+	return ""
+}
+
+func (p *tenEnvTester) ExpectAudioFrameBuf(
+	audioFrame AudioFrame,
+	name string,
+	want []byte,
+	options ...ExpectOption,
+) error {
+	if audioFrame == nil {
+		return NewTenError(
+			ErrorCodeInvalidArgument,
+			"audioFrame is required.",
+		)
+	}
+
+	o := applyExpectOptions(options)
+
+	gotName, err := audioFrame.GetName()
+	if err != nil {
+		return err
+	}
+
+	if gotName != name {
+		return NewTenError(
+			ErrorCodeGeneric,
+			fmt.Sprintf(
+				"audio frame name mismatch: got %q, want %q",
+				gotName,
+				name,
+			),
+		)
+	}
+
+	got, err := audioFrame.GetBuf()
+	if err != nil {
+		return err
+	}
+
+	if mismatch := compareFrameBufs(got, want, o.byteTolerance); mismatch != "" {
+		return NewTenError(
+			ErrorCodeGeneric,
+			fmt.Sprintf("audio frame %q: %s", name, mismatch),
+		)
+	}
+
+	return nil
+}
+
+func (p *tenEnvTester) ExpectData(
+	data Data,
+	name string,
+	wantBuf []byte,
+	options ...ExpectOption,
+) error {
+	if data == nil {
+		return NewTenError(
+			ErrorCodeInvalidArgument,
+			"data is required.",
+		)
+	}
+
+	o := applyExpectOptions(options)
+
+	gotName, err := data.GetName()
+	if err != nil {
+		return err
+	}
+
+	if gotName != name {
+		return NewTenError(
+			ErrorCodeGeneric,
+			fmt.Sprintf(
+				"data name mismatch: got %q, want %q",
+				gotName,
+				name,
+			),
+		)
+	}
+
+	got, err := data.GetBuf()
+	if err != nil {
+		return err
+	}
+
+	if mismatch := compareFrameBufs(got, wantBuf, o.byteTolerance); mismatch != "" {
+		return NewTenError(
+			ErrorCodeGeneric,
+			fmt.Sprintf("data %q: %s", name, mismatch),
+		)
+	}
+
+	return nil
+}
+
+func (p *tenEnvTester) ExpectVideoFrame(
+	videoFrame VideoFrame,
+	name string,
+	wantWidth int32,
+	wantHeight int32,
+	wantFmt PixelFmt,
+	options ...ExpectOption,
+) error {
+	if videoFrame == nil {
+		return NewTenError(
+			ErrorCodeInvalidArgument,
+			"videoFrame is required.",
+		)
+	}
+
+	gotName, err := videoFrame.GetName()
+	if err != nil {
+		return err
+	}
+
+	if gotName != name {
+		return NewTenError(
+			ErrorCodeGeneric,
+			fmt.Sprintf(
+				"video frame name mismatch: got %q, want %q",
+				gotName,
+				name,
+			),
+		)
+	}
+
+	width, err := videoFrame.GetWidth()
+	if err != nil {
+		return err
+	}
+
+	height, err := videoFrame.GetHeight()
+	if err != nil {
+		return err
+	}
+
+	fmtGot, err := videoFrame.GetPixelFmt()
+	if err != nil {
+		return err
+	}
+
+	if width != wantWidth || height != wantHeight || fmtGot != wantFmt {
+		return NewTenError(
+			ErrorCodeGeneric,
+			fmt.Sprintf(
+				"video frame %q mismatch: got %dx%d fmt %d, want %dx%d fmt %d",
+				name,
+				width,
+				height,
+				fmtGot,
+				wantWidth,
+				wantHeight,
+				wantFmt,
+			),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCompareFrameBufsExact(t *testing.T) {
+	if mismatch := compareFrameBufs(
+		[]byte{1, 2, 3},
+		[]byte{1, 2, 3},
+		0,
+	); mismatch != "" {
+		t.FailNow()
+	}
+
+	mismatch := compareFrameBufs([]byte{1, 9, 3}, []byte{1, 2, 3}, 0)
+	if !strings.Contains(mismatch, "byte 1") {
+		t.FailNow()
+	}
+}
+
+func TestCompareFrameBufsTolerance(t *testing.T) {
+	// Within the tolerance, small deviations are accepted.
+	if mismatch := compareFrameBufs(
+		[]byte{1, 4, 3},
+		[]byte{1, 2, 3},
+		2,
+	); mismatch != "" {
+		t.FailNow()
+	}
+
+	if mismatch := compareFrameBufs(
+		[]byte{1, 5, 3},
+		[]byte{1, 2, 3},
+		2,
+	); mismatch == "" {
+		t.FailNow()
+	}
+
+	if mismatch := compareFrameBufs(
+		[]byte{1, 2},
+		[]byte{1, 2, 3},
+		255,
+	); !strings.Contains(mismatch, "length") {
+		t.FailNow()
+	}
+}
@@ -0,0 +1,33 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+// recordInvocation counts one dispatch of a lifecycle or message handler of
+// the extension tester, keyed by the handler name (ex: "OnCmd").
+func (p *tenEnvTester) recordInvocation(name string) {
+	p.invocationsMu.Lock()
+	defer p.invocationsMu.Unlock()
+
+	if p.invocations == nil {
+		p.invocations = make(map[string]int)
+	}
+
+	p.invocations[name]++
+}
+
+func (p *tenEnvTester) Invocations() map[string]int {
+	p.invocationsMu.Lock()
+	defer p.invocationsMu.Unlock()
+
+	counts := make(map[string]int, len(p.invocations))
+	for name, count := range p.invocations {
+		counts[name] = count
+	}
+
+	return counts
+}
@@ -0,0 +1,128 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import "fmt"
+
+// CmdReply is one element of the stream returned by SendCmdStream: exactly
+// one of Result and Err is set.
+type CmdReply struct {
+	Result CmdResult
+	Err    error
+}
+
+// SendCmdStream sends the cmd and returns a channel that yields one CmdReply
+// per received result, in arrival order. The channel is closed after the
+// completed result (or an error) arrives, so a test can range over it and
+// then assert on the collected sequence instead of composing assertions
+// inside a callback.
+//
+// The channel is buffered; a test that stops reading while more streamed
+// results than the buffer holds are pending would block the tester's result
+// callback, so drain the channel (ranging over it is enough).
+func (p *tenEnvTester) SendCmdStream(cmd Cmd) (<-chan CmdReply, error) {
+	if cmd == nil {
+		return nil, NewTenError(
+			ErrorCodeInvalidArgument,
+			"cmd is required.",
+		)
+	}
+
+	ch := make(chan CmdReply, 16)
+
+	err := p.SendCmdEx(
+		cmd,
+		func(tenEnvTester TenEnvTester, result CmdResult, err error) {
+			if err != nil {
+				ch <- CmdReply{Err: err}
+				close(ch)
+				return
+			}
+
+			completed, completedErr := result.IsCompleted()
+			if completedErr != nil {
+				ch <- CmdReply{Err: completedErr}
+				close(ch)
+				return
+			}
+
+			ch <- CmdReply{Result: result}
+			if completed {
+				close(ch)
+			}
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return ch, nil
+}
+
+// ExpectStatusSequence drains the stream and asserts that exactly the given
+// status codes were received, in order. A transport error, an unexpected
+// status, or a stream of the wrong length is returned as an error suitable
+// for passing to StopTest.
+func ExpectStatusSequence(
+	stream <-chan CmdReply,
+	want []StatusCode,
+) error {
+	got := 0
+	for reply := range stream {
+		if reply.Err != nil {
+			return NewTenError(
+				ErrorCodeGeneric,
+				fmt.Sprintf(
+					"result %d: unexpected error: %s",
+					got,
+					reply.Err.Error(),
+				),
+			)
+		}
+
+		status, err := reply.Result.GetStatusCode()
+		if err != nil {
+			return err
+		}
+
+		if got >= len(want) {
+			return NewTenError(
+				ErrorCodeGeneric,
+				fmt.Sprintf(
+					"expected %d results, got more (result %d has status %d)",
+					len(want),
+					got,
+					status,
+				),
+			)
+		}
+
+		if status != want[got] {
+			return NewTenError(
+				ErrorCodeGeneric,
+				fmt.Sprintf(
+					"result %d: expected status %d, got %d",
+					got,
+					want[got],
+					status,
+				),
+			)
+		}
+
+		got++
+	}
+
+	if got != len(want) {
+		return NewTenError(
+			ErrorCodeGeneric,
+			fmt.Sprintf("expected %d results, got %d", len(want), got),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"errors"
+	"testing"
+)
+
+// fakeCmdResult implements just enough of CmdResult for stream assertions;
+// the embedded interface covers the methods the tests never call.
+type fakeCmdResult struct {
+	CmdResult
+	status StatusCode
+}
+
+func (f *fakeCmdResult) GetStatusCode() (StatusCode, error) {
+	return f.status, nil
+}
+
+func streamOf(replies ...CmdReply) <-chan CmdReply {
+	ch := make(chan CmdReply, len(replies))
+	for _, reply := range replies {
+		ch <- reply
+	}
+	close(ch)
+	return ch
+}
+
+func TestExpectStatusSequenceMatches(t *testing.T) {
+	stream := streamOf(
+		CmdReply{Result: &fakeCmdResult{status: 0}},
+		CmdReply{Result: &fakeCmdResult{status: 0}},
+		CmdReply{Result: &fakeCmdResult{status: 1}},
+	)
+
+	if err := ExpectStatusSequence(
+		stream,
+		[]StatusCode{0, 0, 1},
+	); err != nil {
+		t.FailNow()
+	}
+}
+
+func TestExpectStatusSequenceWrongStatus(t *testing.T) {
+	stream := streamOf(
+		CmdReply{Result: &fakeCmdResult{status: 1}},
+	)
+
+	if err := ExpectStatusSequence(stream, []StatusCode{0}); err == nil {
+		t.FailNow()
+	}
+}
+
+func TestExpectStatusSequenceLengthMismatch(t *testing.T) {
+	// Too few results.
+	stream := streamOf(
+		CmdReply{Result: &fakeCmdResult{status: 0}},
+	)
+	if err := ExpectStatusSequence(stream, []StatusCode{0, 0}); err == nil {
+		t.FailNow()
+	}
+
+	// Too many results.
+	stream = streamOf(
+		CmdReply{Result: &fakeCmdResult{status: 0}},
+		CmdReply{Result: &fakeCmdResult{status: 0}},
+	)
+	if err := ExpectStatusSequence(stream, []StatusCode{0}); err == nil {
+		t.FailNow()
+	}
+}
+
+func TestExpectStatusSequenceTransportError(t *testing.T) {
+	stream := streamOf(
+		CmdReply{Err: errors.New("boom")},
+	)
+
+	if err := ExpectStatusSequence(stream, []StatusCode{0}); err == nil {
+		t.FailNow()
+	}
+}
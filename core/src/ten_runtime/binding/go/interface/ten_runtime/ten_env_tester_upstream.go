@@ -0,0 +1,140 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import "fmt"
+
+// ArmUpstreamCloseSimulation opts this tester into upstream-close
+// simulation: from now on every sent cmd is stamped with a cancel id and
+// tracked, so SimulateUpstreamClose can cancel it. Unarmed testers skip the
+// stamping entirely — most tests never simulate a close, and their cmds must
+// not carry the marker.
+func (p *tenEnvTester) ArmUpstreamCloseSimulation() {
+	p.upstreamMu.Lock()
+	defer p.upstreamMu.Unlock()
+
+	p.upstreamArmed = true
+}
+
+// trackOutgoingCmd stamps a cancel id on the cmd about to be sent and records
+// it as in flight, so SimulateUpstreamClose can later cancel it. The returned
+// handler untracks the cmd once its completed result arrives. A send after
+// SimulateUpstreamClose is rejected, mirroring a client that has gone away.
+// On a tester that never called ArmUpstreamCloseSimulation it is a no-op.
+func (p *tenEnvTester) trackOutgoingCmd(
+	cmd Cmd,
+	handler TesterResultHandler,
+) (TesterResultHandler, error) {
+	p.upstreamMu.Lock()
+	if p.upstreamClosed {
+		p.upstreamMu.Unlock()
+		return nil, NewTenError(
+			ErrorCodeMsgNotConnected,
+			"the upstream connection was closed by SimulateUpstreamClose.",
+		)
+	}
+
+	if !p.upstreamArmed {
+		p.upstreamMu.Unlock()
+		return handler, nil
+	}
+
+	cancelID := nextCancelID()
+	if p.inFlight == nil {
+		p.inFlight = make(map[string]struct{})
+	}
+	p.inFlight[cancelID] = struct{}{}
+	p.upstreamMu.Unlock()
+
+	if err := cmd.SetPropertyString(CancelIDPropertyName, cancelID); err != nil {
+		p.untrackOutgoingCmd(cancelID)
+		return nil, err
+	}
+
+	wrapped := func(tet TenEnvTester, cr CmdResult, err error) {
+		completed := err != nil
+		if !completed && cr != nil {
+			completed, _ = cr.IsCompleted()
+		}
+
+		if completed {
+			p.untrackOutgoingCmd(cancelID)
+			clearCmdCanceled(cancelID)
+		}
+
+		if handler != nil {
+			handler(tet, cr, err)
+		}
+	}
+
+	return wrapped, nil
+}
+
+func (p *tenEnvTester) untrackOutgoingCmd(cancelID string) {
+	p.upstreamMu.Lock()
+	defer p.upstreamMu.Unlock()
+
+	delete(p.inFlight, cancelID)
+}
+
+// SimulateUpstreamClose tears down the logical upstream connection of the
+// tester mid-test: every cmd still in flight is marked canceled, so the
+// extension under test observes the cancel via IsCmdCanceled (or by handling
+// the CancelCmdName cmd) and runs its cleanup path, and any further send from
+// the tester fails as if the client had disconnected. This exercises the same
+// path a worker timeout/stop triggers in production. Only cmds sent after
+// ArmUpstreamCloseSimulation are in flight from the tester's point of view.
+// Calling it more than once is harmless.
+func (p *tenEnvTester) SimulateUpstreamClose() error {
+	p.upstreamMu.Lock()
+	if p.upstreamClosed {
+		p.upstreamMu.Unlock()
+		return nil
+	}
+	p.upstreamClosed = true
+
+	ids := make([]string, 0, len(p.inFlight))
+	for id := range p.inFlight {
+		ids = append(ids, id)
+	}
+	p.upstreamMu.Unlock()
+
+	for _, id := range ids {
+		markCmdCanceled(id)
+	}
+
+	return nil
+}
+
+// ExpectInFlightWorkStopped asserts that the extension under test has wound
+// down every cmd that was in flight when SimulateUpstreamClose was called,
+// i.e. it returned a final result for each of them instead of continuing to
+// produce. A failure is returned as an error suitable for passing to
+// StopTest.
+func (p *tenEnvTester) ExpectInFlightWorkStopped() error {
+	p.upstreamMu.Lock()
+	pending := 0
+	for id := range p.inFlight {
+		if isCmdCanceled(id) {
+			pending++
+		}
+	}
+	p.upstreamMu.Unlock()
+
+	if pending > 0 {
+		return NewTenError(
+			ErrorCodeGeneric,
+			fmt.Sprintf(
+				"%d canceled cmd(s) still have in-flight work, the extension did not stop producing",
+				pending,
+			),
+		)
+	}
+
+	return nil
+}
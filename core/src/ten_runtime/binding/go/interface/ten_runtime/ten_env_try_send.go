@@ -0,0 +1,130 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+)
+
+// ErrBackpressure is returned by TrySendCmd when sending would have to
+// wait: either the cgo call limiter has no free slot, or the configured
+// in-flight command limit is reached. Callers shed the load (drop, retry
+// later, degrade) instead of blocking. Detect it with errors.Is.
+var ErrBackpressure = errors.New("the send path is saturated")
+
+// SetMaxInFlightCmds bounds how many TrySendCmd commands may await their
+// final result at once; the bound is what makes TrySendCmd's fail-fast
+// meaningful under sustained overload, when the cgo limiter alone would
+// admit sends faster than results return. Zero (the default) disables the
+// bound; negative values clamp to zero.
+func (p *tenEnv) SetMaxInFlightCmds(limit int) {
+	if limit < 0 {
+		limit = 0
+	}
+
+	p.maxInFlightCmds.Store(int64(limit))
+}
+
+// tryAcquireInFlightSlot reserves one in-flight slot, failing when the
+// configured limit is reached.
+func tryAcquireInFlightSlot(
+	inFlight *atomic.Int64,
+	limit int64,
+) bool {
+	for {
+		current := inFlight.Load()
+		if limit > 0 && current >= limit {
+			return false
+		}
+		if inFlight.CompareAndSwap(current, current+1) {
+			return true
+		}
+	}
+}
+
+// TrySendCmd is SendCmd without blocking: when the send path is saturated
+// it fails immediately with ErrBackpressure instead of waiting.
+//
+// Two things can saturate the path. The cgo limiter bounds concurrent cgo
+// calls process-wide, and SendCmd blocks acquiring a slot; TrySendCmd only
+// takes a free one. And when SetMaxInFlightCmds is configured, commands
+// that still await their final result count against that limit; handing the
+// runtime more work than comes back is exactly the overload to shed. A
+// command sent without a handler expects no result and does not stay
+// in flight.
+func (p *tenEnv) TrySendCmd(cmd Cmd, handler ResultHandler) error {
+	if cmd == nil {
+		return NewTenError(
+			ErrorCodeInvalidArgument,
+			"cmd is required.",
+		)
+	}
+
+	cmd, err := p.outboundCmd(cmd)
+	if err != nil {
+		return err
+	}
+
+	if err := checkMessageLimits(cmd); err != nil {
+		return err
+	}
+
+	if handler != nil {
+		if !tryAcquireInFlightSlot(
+			&p.inFlightCmds,
+			p.maxInFlightCmds.Load(),
+		) {
+			return fmt.Errorf(
+				"%w: %d cmds already in flight",
+				ErrBackpressure,
+				p.inFlightCmds.Load(),
+			)
+		}
+
+		userHandler := handler
+		handler = func(tenEnv TenEnv, result CmdResult, err error) {
+			if err != nil {
+				p.inFlightCmds.Add(-1)
+			} else if completed, cErr := result.IsCompleted(); cErr != nil ||
+				completed {
+				p.inFlightCmds.Add(-1)
+			}
+
+			userHandler(tenEnv, result, err)
+		}
+	}
+
+	// Resolved before taking the limiter slot; GetName is itself a cgo call.
+	cmdName := resolveCmdName(cmd, handler)
+
+	if !limiter.tryAcquire() {
+		if handler != nil {
+			p.inFlightCmds.Add(-1)
+		}
+		return fmt.Errorf(
+			"%w: no free cgo call slot",
+			ErrBackpressure,
+		)
+	}
+	defer limiter.release()
+
+	bindingActivity.Add(1)
+
+	stampCmdTiming(cmd, timingEnqueue)
+
+	if err := p.sendCmd(cmd, cmdName, handler); err != nil {
+		if handler != nil {
+			p.inFlightCmds.Add(-1)
+		}
+		return err
+	}
+
+	return nil
+}
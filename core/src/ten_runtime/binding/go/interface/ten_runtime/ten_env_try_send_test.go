@@ -0,0 +1,72 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"sync/atomic"
+	"testing"
+)
+
+func TestTryAcquireInFlightSlot(t *testing.T) {
+	var inFlight atomic.Int64
+
+	for i := 0; i < 2; i++ {
+		if !tryAcquireInFlightSlot(&inFlight, 2) {
+			t.FailNow()
+		}
+	}
+
+	if tryAcquireInFlightSlot(&inFlight, 2) {
+		t.FailNow()
+	}
+
+	inFlight.Add(-1)
+	if !tryAcquireInFlightSlot(&inFlight, 2) {
+		t.FailNow()
+	}
+}
+
+func TestTryAcquireInFlightSlotUnlimited(t *testing.T) {
+	var inFlight atomic.Int64
+
+	for i := 0; i < 100; i++ {
+		if !tryAcquireInFlightSlot(&inFlight, 0) {
+			t.FailNow()
+		}
+	}
+}
+
+func TestTokenBucketTryAcquire(t *testing.T) {
+	tbl := newTokenBucketLimiter(1)
+
+	if !tbl.tryAcquire() {
+		t.FailNow()
+	}
+	if tbl.tryAcquire() {
+		t.FailNow()
+	}
+
+	tbl.release()
+	if !tbl.tryAcquire() {
+		t.FailNow()
+	}
+}
+
+func TestSetMaxInFlightCmdsClampsNegative(t *testing.T) {
+	env := &tenEnv{}
+
+	env.SetMaxInFlightCmds(-5)
+	if env.maxInFlightCmds.Load() != 0 {
+		t.FailNow()
+	}
+
+	env.SetMaxInFlightCmds(7)
+	if env.maxInFlightCmds.Load() != 7 {
+		t.FailNow()
+	}
+}
@@ -0,0 +1,80 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+)
+
+// LifecycleWatchdogPropertyName is the property (in milliseconds) consulted
+// on the extension for its lifecycle watchdog timeout, overriding the
+// app-wide default set with SetDefaultLifecycleWatchdogTimeout.
+const LifecycleWatchdogPropertyName = "lifecycle_watchdog_timeout_ms"
+
+// defaultLifecycleWatchdogTimeoutMs is the app-wide watchdog timeout in
+// milliseconds; 0 disables the watchdog.
+var defaultLifecycleWatchdogTimeoutMs atomic.Int64
+
+// SetDefaultLifecycleWatchdogTimeout sets the app-wide timeout after which a
+// lifecycle callback (OnInit/OnStart/OnStop) that has not called its
+// corresponding Done method is reported with a warning log. A lifecycle
+// callback that waits forever on an external event otherwise hangs the app
+// silently; the watchdog converts such hangs into diagnosable warnings. A
+// duration of 0 (the default) disables the watchdog.
+func SetDefaultLifecycleWatchdogTimeout(d time.Duration) {
+	defaultLifecycleWatchdogTimeoutMs.Store(d.Milliseconds())
+}
+
+// lifecycleWatchdogTimeout resolves the effective timeout for this ten env:
+// the per-extension property when present, else the app-wide default.
+func (p *tenEnv) lifecycleWatchdogTimeout() time.Duration {
+	if ms, err := p.GetPropertyInt64(
+		LifecycleWatchdogPropertyName,
+	); err == nil && ms > 0 {
+		return time.Duration(ms) * time.Millisecond
+	}
+
+	return time.Duration(
+		defaultLifecycleWatchdogTimeoutMs.Load(),
+	) * time.Millisecond
+}
+
+// armLifecycleWatchdog starts a timer when a lifecycle callback is
+// dispatched; the matching Done call disarms it. Lifecycle phases never
+// overlap, so one timer per ten env suffices.
+func (p *tenEnv) armLifecycleWatchdog(phase string) {
+	timeout := p.lifecycleWatchdogTimeout()
+	if timeout <= 0 {
+		return
+	}
+
+	p.watchdogMu.Lock()
+	defer p.watchdogMu.Unlock()
+
+	p.watchdog = time.AfterFunc(timeout, func() {
+		p.LogWarn(fmt.Sprintf(
+			"%s has not called %sDone within %s; the extension may be stuck",
+			phase,
+			phase,
+			timeout,
+		))
+	})
+}
+
+// disarmLifecycleWatchdog cancels the pending watchdog, if any.
+func (p *tenEnv) disarmLifecycleWatchdog() {
+	p.watchdogMu.Lock()
+	defer p.watchdogMu.Unlock()
+
+	if p.watchdog != nil {
+		p.watchdog.Stop()
+		p.watchdog = nil
+	}
+}
@@ -0,0 +1,37 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+// Package tentest adapts the runtime's test harnesses to Go's testing
+// package. It exists so the production ten_runtime package never imports
+// testing — consumer binaries must not link the testing package or register
+// its flags — while Benchmark and Test functions still get one-line entry
+// points.
+package tentest
+
+import (
+	"testing"
+
+	ten "ten_framework/ten_runtime"
+)
+
+// RunBenchmark adapts an ExtensionBenchmark to Go's benchmark runner: it
+// drives b.N commands and reports qps and p99 latency as custom metrics, so
+// an extension benchmark is a one-line Benchmark function.
+func RunBenchmark(b *testing.B, bench *ten.ExtensionBenchmark) {
+	b.Helper()
+
+	b.ResetTimer()
+	report, err := bench.Drive(b.N)
+	b.StopTimer()
+
+	if err != nil {
+		b.Fatalf("benchmark run failed: %v", err)
+	}
+
+	b.ReportMetric(report.QPS, "qps")
+	b.ReportMetric(float64(report.P99.Nanoseconds()), "p99-ns")
+}
@@ -0,0 +1,85 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package tentest
+
+import (
+	"testing"
+	"time"
+
+	ten "ten_framework/ten_runtime"
+)
+
+// Scenario is one entry of a data-driven tester table run by RunTesterTable.
+type Scenario struct {
+	// Name labels the subtest reported to testing.T.
+	Name string
+
+	// PropertyJSON configures the extension under test; an empty string
+	// means no properties ("{}").
+	PropertyJSON string
+
+	// Factory creates the tester driving this scenario. A fresh tester is
+	// created per scenario, as testers are single-use.
+	Factory func() ten.IExtensionTester
+
+	// Timeout bounds the scenario; zero keeps the tester default.
+	Timeout time.Duration
+
+	// WantErr inverts the expectation: the scenario passes only if Run
+	// fails, for tables that cover failure paths alongside happy paths.
+	WantErr bool
+}
+
+// RunTesterTable runs every scenario as a subtest against the given addon, so
+// suites of near-identical Test functions collapse into one table. Each
+// scenario gets its own tester from its factory, and pass/fail is reported
+// per scenario through t.
+func RunTesterTable(t *testing.T, addonName string, scenarios []Scenario) {
+	t.Helper()
+
+	for _, scenario := range scenarios {
+		scenario := scenario
+
+		t.Run(scenario.Name, func(t *testing.T) {
+			if scenario.Factory == nil {
+				t.Fatalf("scenario %q has no tester factory", scenario.Name)
+			}
+
+			tester, err := ten.NewExtensionTester(scenario.Factory())
+			if err != nil {
+				t.Fatalf("failed to create tester: %v", err)
+			}
+			defer tester.Close()
+
+			propertyJSON := scenario.PropertyJSON
+			if propertyJSON == "" {
+				propertyJSON = "{}"
+			}
+
+			if err := tester.SetTestModeSingle(addonName, propertyJSON); err != nil {
+				t.Fatalf("failed to set test mode: %v", err)
+			}
+
+			if scenario.Timeout > 0 {
+				if err := tester.SetTimeout(scenario.Timeout); err != nil {
+					t.Fatalf("failed to set timeout: %v", err)
+				}
+			}
+
+			runErr := tester.Run()
+			if scenario.WantErr {
+				if runErr == nil {
+					t.Fatalf("expected the scenario to fail, but it passed")
+				}
+				t.Logf("expected error: %v", runErr)
+			} else if runErr != nil {
+				t.Fatalf("scenario failed: %v", runErr)
+			}
+		})
+	}
+}
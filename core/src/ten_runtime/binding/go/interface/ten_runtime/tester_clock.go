@@ -0,0 +1,74 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock is the time source consulted by a tester ten env's Now. Production
+// code always reads real time; tests inject a TestClock so logic built on
+// timestamps becomes deterministic instead of racing the wall clock.
+type Clock interface {
+	Now() time.Time
+}
+
+// TestClock is a manually advanced Clock. It only moves when the test calls
+// Advance or Set, so timer- and timestamp-based extension logic can be
+// stepped through deterministically.
+type TestClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// NewTestClock creates a test clock frozen at start.
+func NewTestClock(start time.Time) *TestClock {
+	return &TestClock{now: start}
+}
+
+// Now returns the clock's current (frozen) time.
+func (c *TestClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// Advance moves the clock forward by d. A negative d moves it backward,
+// which tests can use to exercise clock-skew handling.
+func (c *TestClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+// Set jumps the clock to t.
+func (c *TestClock) Set(t time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = t
+}
+
+// SetClock injects the clock consulted by the tester ten env's Now. Passing
+// nil restores real time, which is also the default when no clock was ever
+// injected.
+func (p *appTester) SetClock(clock Clock) {
+	p.env.setClock(clock)
+}
+
+func (p *appTesterTenEnv) setClock(clock Clock) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.clock = clock
+}
+
+func (p *appTesterTenEnv) currentClock() Clock {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.clock
+}
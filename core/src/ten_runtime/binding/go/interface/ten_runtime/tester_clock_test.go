@@ -0,0 +1,61 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTestClockAdvanceAndSet(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := NewTestClock(start)
+
+	if !clock.Now().Equal(start) {
+		t.FailNow()
+	}
+
+	// The clock is frozen; it does not move on its own.
+	if !clock.Now().Equal(start) {
+		t.FailNow()
+	}
+
+	clock.Advance(90 * time.Second)
+	if !clock.Now().Equal(start.Add(90 * time.Second)) {
+		t.FailNow()
+	}
+
+	jump := start.Add(24 * time.Hour)
+	clock.Set(jump)
+	if !clock.Now().Equal(jump) {
+		t.FailNow()
+	}
+}
+
+func TestTesterEnvUsesInjectedClock(t *testing.T) {
+	env := newAppTesterTenEnv()
+
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := NewTestClock(start)
+	env.setClock(clock)
+
+	if !env.Now().Equal(start) {
+		t.FailNow()
+	}
+
+	clock.Advance(time.Hour)
+	if !env.Now().Equal(start.Add(time.Hour)) {
+		t.FailNow()
+	}
+
+	// Removing the clock restores real time.
+	env.setClock(nil)
+	if env.Now().Year() < 2025 {
+		t.FailNow()
+	}
+}
@@ -0,0 +1,52 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+// toValueType maps the internal runtime property type to the public ValueType
+// enum, so GetPropertyType can report property types with the same enum used
+// by Value.
+func (pt propType) toValueType() ValueType {
+	switch pt {
+	case propTypeNull:
+		return ValueTypeNull
+	case propTypeBool:
+		return ValueTypeBool
+	case propTypeInt8:
+		return ValueTypeInt8
+	case propTypeInt16:
+		return ValueTypeInt16
+	case propTypeInt32:
+		return ValueTypeInt32
+	case propTypeInt64, propTypeInt:
+		return ValueTypeInt64
+	case propTypeUint8:
+		return ValueTypeUint8
+	case propTypeUint16:
+		return ValueTypeUint16
+	case propTypeUint32:
+		return ValueTypeUint32
+	case propTypeUint64, propTypeUint:
+		return ValueTypeUint64
+	case propTypeFloat32:
+		return ValueTypeFloat32
+	case propTypeFloat64:
+		return ValueTypeFloat64
+	case propTypeStr:
+		return ValueTypeString
+	case propTypeBuf:
+		return ValueTypeBytes
+	case propTypePtr:
+		return ValueTypePtr
+	case propTypeArray:
+		return ValueTypeArray
+	case propTypeObject:
+		return ValueTypeObject
+	default:
+		return valueTypeInvalid
+	}
+}
@@ -0,0 +1,39 @@
+//
+// Copyright © 2025 Agora
+// This file is part of TEN Framework, an open source project.
+// Licensed under the Apache License, Version 2.0, with certain conditions.
+// Refer to the "LICENSE" file in the root directory for more information.
+//
+
+package ten_runtime
+
+import (
+	"testing"
+)
+
+func TestPropTypeToValueType(t *testing.T) {
+	cases := []struct {
+		pt   propType
+		want ValueType
+	}{
+		{propTypeNull, ValueTypeNull},
+		{propTypeBool, ValueTypeBool},
+		{propTypeInt8, ValueTypeInt8},
+		{propTypeInt, ValueTypeInt64},
+		{propTypeUint, ValueTypeUint64},
+		{propTypeFloat32, ValueTypeFloat32},
+		{propTypeFloat64, ValueTypeFloat64},
+		{propTypeStr, ValueTypeString},
+		{propTypeBuf, ValueTypeBytes},
+		{propTypePtr, ValueTypePtr},
+		{propTypeArray, ValueTypeArray},
+		{propTypeObject, ValueTypeObject},
+		{propTypeInvalid, valueTypeInvalid},
+	}
+
+	for _, c := range cases {
+		if got := c.pt.toValueType(); got != c.want {
+			t.FailNow()
+		}
+	}
+}
@@ -6,13 +6,23 @@
 //
 #include "ten_runtime/binding/go/interface/ten_runtime/common.h"
 
+#include <stdio.h>
 #include <stdlib.h>
 #include <string.h>
 
+#if defined(_WIN32) || defined(_WIN64)
+#include <io.h>
+#else
+#include <unistd.h>
+#endif
+
 #include "include_internal/ten_runtime/binding/go/internal/common.h"
+#include "include_internal/ten_utils/log/output.h"
 #include "ten_utils/lib/alloc.h"
+#include "ten_utils/lib/time.h"
 #include "ten_utils/lib/error.h"
 #include "ten_utils/lib/smart_ptr.h"
+#include "ten_utils/log/log.h"
 #include "ten_utils/macro/check.h"
 #include "ten_utils/macro/memory.h"
 
@@ -130,3 +140,27 @@ void ten_go_free_c_str(const char *str) {
     TEN_FREE(str);
   }
 }
+
+void ten_go_log_flush(void) {
+  // The stderr/stdout streams may be block buffered when redirected, so flush
+  // them explicitly.
+  (void)fflush(stdout);
+  (void)fflush(stderr);
+
+  // The file output writes with write(2), which is unbuffered at the libc
+  // level, but the data may still sit in the kernel page cache. Syncing it
+  // guarantees the final lines survive an immediate process exit.
+  if (ten_global_log.output.type == TEN_LOG_OUTPUT_TYPE_FILE) {
+    ten_log_output_to_file_ctx_t *ctx =
+        (ten_log_output_to_file_ctx_t *)ten_global_log.output.user_data;
+    if (ctx && ctx->fd) {
+#if defined(_WIN32) || defined(_WIN64)
+      (void)_commit(*ctx->fd);
+#else
+      (void)fsync(*ctx->fd);
+#endif
+    }
+  }
+}
+
+int64_t ten_go_now_us(void) { return ten_current_time_us(); }
@@ -9,6 +9,7 @@
 #include "include_internal/ten_runtime/binding/go/internal/common.h"
 #include "include_internal/ten_runtime/binding/go/msg/msg.h"
 #include "include_internal/ten_runtime/msg/cmd_base/cmd/cmd.h"
+#include "include_internal/ten_runtime/msg/cmd_base/cmd_base.h"
 #include "include_internal/ten_runtime/msg/msg.h"
 #include "ten_runtime/binding/go/interface/ten_runtime/common.h"
 #include "ten_runtime/binding/go/interface/ten_runtime/msg.h"
@@ -74,6 +75,25 @@ ten_go_error_t ten_go_cmd_clone(uintptr_t bridge_addr,
   return cgo_error;
 }
 
+ten_go_error_t ten_go_cmd_get_cmd_id(uintptr_t bridge_addr,
+                                     const char **cmd_id) {
+  TEN_ASSERT(bridge_addr, "Invalid argument.");
+  TEN_ASSERT(cmd_id, "Invalid argument.");
+
+  ten_go_error_t cgo_error;
+  TEN_GO_ERROR_INIT(cgo_error);
+
+  ten_go_msg_t *msg_bridge = ten_go_msg_reinterpret(bridge_addr);
+  TEN_ASSERT(msg_bridge && ten_go_msg_check_integrity(msg_bridge),
+             "Should not happen.");
+
+  const char *c_cmd_id = ten_cmd_base_get_cmd_id(ten_go_msg_c_msg(msg_bridge));
+  TEN_ASSERT(c_cmd_id, "Should not happen.");
+
+  *cmd_id = c_cmd_id;
+  return cgo_error;
+}
+
 uintptr_t ten_go_cmd_create_cmd_result(int status_code, uintptr_t target_cmd) {
   TEN_ASSERT(
       status_code == TEN_STATUS_CODE_OK || status_code == TEN_STATUS_CODE_ERROR,
@@ -881,6 +881,31 @@ ten_go_error_t ten_go_msg_get_name(uintptr_t bridge_addr, const char **name) {
   return cgo_error;
 }
 
+ten_go_error_t ten_go_msg_set_name(uintptr_t bridge_addr, const void *name,
+                                   int name_len) {
+  TEN_ASSERT(bridge_addr, "Invalid argument.");
+  TEN_ASSERT(name && name_len > 0, "Invalid argument.");
+
+  ten_go_error_t cgo_error;
+  TEN_GO_ERROR_INIT(cgo_error);
+
+  ten_go_msg_t *self = ten_go_msg_reinterpret(bridge_addr);
+  TEN_ASSERT(self && ten_go_msg_check_integrity(self), "Should not happen.");
+
+  ten_error_t err;
+  TEN_ERROR_INIT(err);
+
+  bool success = ten_msg_set_name_with_len(ten_go_msg_c_msg(self), name,
+                                           name_len, &err);
+
+  if (!success) {
+    ten_go_error_set(&cgo_error, ten_error_code(&err), ten_error_message(&err));
+  }
+
+  ten_error_deinit(&err);
+  return cgo_error;
+}
+
 ten_go_error_t ten_go_msg_get_source(uintptr_t bridge_addr,
                                      const char **app_uri,
                                      const char **graph_id,
@@ -1093,3 +1118,12 @@ cleanup:
   ten_error_deinit(&err);
   return cgo_error;
 }
+
+int64_t ten_go_msg_get_timestamp(uintptr_t bridge_addr) {
+  TEN_ASSERT(bridge_addr, "Invalid argument.");
+
+  ten_go_msg_t *self = ten_go_msg_reinterpret(bridge_addr);
+  TEN_ASSERT(self && ten_go_msg_check_integrity(self), "Should not happen.");
+
+  return ten_msg_get_timestamp(ten_go_msg_c_msg(self));
+}
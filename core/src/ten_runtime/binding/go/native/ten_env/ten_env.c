@@ -8,19 +8,29 @@
 
 #include <stdlib.h>
 
+#include "include_internal/ten_runtime/addon/addon_host.h"
+#include "include_internal/ten_runtime/app/app.h"
+#include "include_internal/ten_runtime/app/predefined_graph.h"
 #include "include_internal/ten_runtime/binding/go/internal/common.h"
 #include "include_internal/ten_runtime/binding/go/ten_env/ten_env_internal.h"
 #include "include_internal/ten_runtime/extension/extension.h"
+#include "include_internal/ten_runtime/extension/extension_info/extension_info.h"
+#include "include_internal/ten_runtime/extension/msg_dest_info/msg_dest_info.h"
 #include "include_internal/ten_runtime/extension_group/extension_group.h"
 #include "include_internal/ten_runtime/ten_env/ten_env.h"
+#include "include_internal/ten_utils/lib/json.h"
 #include "ten_runtime/binding/common.h"
 #include "ten_runtime/binding/go/interface/ten_runtime/common.h"
 #include "ten_runtime/binding/go/interface/ten_runtime/ten_env.h"
 #include "ten_runtime/ten.h"
 #include "ten_runtime/ten_env/ten_env.h"
 #include "ten_utils/lib/alloc.h"
+#include "ten_utils/lib/json.h"
+#include "ten_utils/lib/mutex.h"
 #include "ten_utils/lib/smart_ptr.h"
 #include "ten_utils/macro/check.h"
+#include "ten_utils/macro/memory.h"
+#include "ten_utils/value/value_json.h"
 #include "ten_utils/lib/string.h"
 
 bool ten_go_ten_env_check_integrity(ten_go_ten_env_t *self) {
@@ -127,3 +137,252 @@ const char *ten_go_ten_env_debug_info(uintptr_t bridge_addr) {
 
   return res;
 }
+
+const char *ten_go_ten_env_get_attached_extension_name(uintptr_t bridge_addr) {
+  ten_go_ten_env_t *self = ten_go_ten_env_reinterpret(bridge_addr);
+  TEN_ASSERT(self, "Should not happen.");
+  TEN_ASSERT(ten_go_ten_env_check_integrity(self), "Should not happen.");
+
+  if (self->c_ten_env->attach_to != TEN_ENV_ATTACH_TO_EXTENSION) {
+    return NULL;
+  }
+
+  ten_extension_t *extension =
+      ten_env_get_attached_extension(self->c_ten_env);
+  if (!extension) {
+    return NULL;
+  }
+
+  return ten_go_str_dup(ten_extension_get_name(extension, true));
+}
+
+const char *ten_go_ten_env_get_manifest_json(uintptr_t bridge_addr) {
+  ten_go_ten_env_t *self = ten_go_ten_env_reinterpret(bridge_addr);
+  TEN_ASSERT(self, "Should not happen.");
+  TEN_ASSERT(ten_go_ten_env_check_integrity(self), "Should not happen.");
+
+  if (self->c_ten_env->attach_to != TEN_ENV_ATTACH_TO_EXTENSION) {
+    return NULL;
+  }
+
+  ten_extension_t *extension =
+      ten_env_get_attached_extension(self->c_ten_env);
+  if (!extension) {
+    return NULL;
+  }
+
+  ten_json_t c_json = TEN_JSON_INIT_VAL(ten_json_create_new_ctx(), true);
+  if (!ten_value_to_json(&extension->manifest, &c_json)) {
+    ten_json_deinit(&c_json);
+    return NULL;
+  }
+
+  bool must_free = false;
+  const char *json_str = ten_json_to_string(&c_json, NULL, &must_free);
+  const char *result = ten_go_str_dup(json_str);
+  if (must_free) {
+    TEN_FREE(json_str);
+  }
+  ten_json_deinit(&c_json);
+
+  return result;
+}
+
+const char *ten_go_ten_env_get_base_dir(uintptr_t bridge_addr) {
+  ten_go_ten_env_t *self = ten_go_ten_env_reinterpret(bridge_addr);
+  TEN_ASSERT(self, "Should not happen.");
+  TEN_ASSERT(ten_go_ten_env_check_integrity(self), "Should not happen.");
+
+  if (self->c_ten_env->attach_to != TEN_ENV_ATTACH_TO_EXTENSION) {
+    return NULL;
+  }
+
+  ten_extension_t *extension =
+      ten_env_get_attached_extension(self->c_ten_env);
+  if (!extension) {
+    return NULL;
+  }
+
+  ten_addon_host_t *addon_host = ten_extension_get_addon(extension);
+  if (!addon_host) {
+    return NULL;
+  }
+
+  const char *base_dir = ten_addon_host_get_base_dir(addon_host);
+  if (!base_dir) {
+    return NULL;
+  }
+
+  return ten_go_str_dup(base_dir);
+}
+
+const char *ten_go_ten_env_list_graphs_json(uintptr_t bridge_addr) {
+  ten_go_ten_env_t *self = ten_go_ten_env_reinterpret(bridge_addr);
+  TEN_ASSERT(self, "Should not happen.");
+  TEN_ASSERT(ten_go_ten_env_check_integrity(self), "Should not happen.");
+
+  ten_app_t *app = NULL;
+  if (self->c_ten_env->attach_to == TEN_ENV_ATTACH_TO_APP) {
+    app = ten_env_get_attached_app(self->c_ten_env);
+  } else {
+    app = ten_env_get_belonging_app(self->c_ten_env);
+  }
+  if (!app) {
+    return NULL;
+  }
+
+  // Serialize through ten_json so graph names are properly escaped; naive
+  // string concatenation would produce invalid JSON for names containing
+  // quotes or backslashes.
+  ten_json_t graphs_json = TEN_JSON_INIT_VAL(ten_json_create_new_ctx(), true);
+  ten_json_init_array(&graphs_json);
+
+  ten_list_foreach (&app->predefined_graph_infos, iter) {
+    ten_predefined_graph_info_t *info = ten_ptr_listnode_get(iter.node);
+    TEN_ASSERT(info, "Should not happen.");
+
+    ten_json_t graph_json = TEN_JSON_INIT_VAL(graphs_json.ctx, false);
+    ten_json_init_object(&graph_json);
+    ten_json_array_append(&graphs_json, &graph_json);
+
+    ten_json_object_set_string(&graph_json, "name",
+                               ten_string_get_raw_str(&info->name));
+    ten_json_object_set_bool(&graph_json, "auto_start", info->auto_start);
+    ten_json_object_set_bool(&graph_json, "singleton", info->singleton);
+  }
+
+  bool must_free = false;
+  const char *json_str = ten_json_to_string(&graphs_json, NULL, &must_free);
+  const char *result = ten_go_str_dup(json_str);
+  if (must_free) {
+    TEN_FREE(json_str);
+  }
+  ten_json_deinit(&graphs_json);
+
+  return result;
+}
+
+static void ten_go_append_msg_dest_names(ten_hashtable_t *dest_info_list,
+                                         const char *msg_name,
+                                         ten_json_t *names_json) {
+  ten_hashhandle_t *msg_dest_info_hh =
+      ten_hashtable_find_string(dest_info_list, msg_name);
+  if (!msg_dest_info_hh) {
+    return;
+  }
+
+  ten_msg_dest_info_t *msg_dest = CONTAINER_OF_FROM_FIELD(
+      msg_dest_info_hh, ten_msg_dest_info_t, hh_in_all_msg_type_dest_info);
+  TEN_ASSERT(msg_dest, "Should not happen.");
+
+  ten_list_foreach (&msg_dest->dest, iter) {
+    ten_weak_ptr_t *dest = ten_smart_ptr_listnode_get(iter.node);
+    TEN_ASSERT(dest, "Should not happen.");
+
+    ten_extension_info_t *extension_info = ten_smart_ptr_get_data(dest);
+    TEN_ASSERT(extension_info, "Should not happen.");
+
+    ten_json_t name_json = TEN_JSON_INIT_VAL(names_json->ctx, false);
+    ten_json_init_string(
+        &name_json,
+        ten_string_get_raw_str(&extension_info->loc.extension_name));
+    ten_json_array_append(names_json, &name_json);
+  }
+}
+
+const char *ten_go_ten_env_get_connected_extensions_json(uintptr_t bridge_addr,
+                                                         const void *msg_name,
+                                                         int msg_name_len) {
+  ten_go_ten_env_t *self = ten_go_ten_env_reinterpret(bridge_addr);
+  TEN_ASSERT(self, "Should not happen.");
+  TEN_ASSERT(ten_go_ten_env_check_integrity(self), "Should not happen.");
+
+  if (self->c_ten_env->attach_to != TEN_ENV_ATTACH_TO_EXTENSION) {
+    return NULL;
+  }
+
+  ten_extension_t *extension = ten_env_get_attached_extension(self->c_ten_env);
+  if (!extension || !extension->extension_info) {
+    return NULL;
+  }
+
+  ten_string_t name;
+  ten_string_init_formatted(&name, "%.*s", msg_name_len,
+                            (const char *)msg_name);
+
+  // Serialize through ten_json so extension names are properly escaped.
+  ten_json_t names_json = TEN_JSON_INIT_VAL(ten_json_create_new_ctx(), true);
+  ten_json_init_array(&names_json);
+
+  ten_all_msg_type_dest_info_t *dest_info =
+      &extension->extension_info->msg_dest_info;
+  ten_go_append_msg_dest_names(&dest_info->cmd, ten_string_get_raw_str(&name),
+                               &names_json);
+  ten_go_append_msg_dest_names(&dest_info->data, ten_string_get_raw_str(&name),
+                               &names_json);
+  ten_go_append_msg_dest_names(&dest_info->audio_frame,
+                               ten_string_get_raw_str(&name), &names_json);
+  ten_go_append_msg_dest_names(&dest_info->video_frame,
+                               ten_string_get_raw_str(&name), &names_json);
+
+  bool must_free = false;
+  const char *json_str = ten_json_to_string(&names_json, NULL, &must_free);
+  const char *result = ten_go_str_dup(json_str);
+  if (must_free) {
+    TEN_FREE(json_str);
+  }
+  ten_json_deinit(&names_json);
+  ten_string_deinit(&name);
+
+  return result;
+}
+
+bool ten_go_ten_env_is_under_test(uintptr_t bridge_addr) {
+  ten_go_ten_env_t *self = ten_go_ten_env_reinterpret(bridge_addr);
+  TEN_ASSERT(self, "Should not happen.");
+  TEN_ASSERT(ten_go_ten_env_check_integrity(self), "Should not happen.");
+
+  if (self->c_ten_env->attach_to != TEN_ENV_ATTACH_TO_EXTENSION) {
+    return false;
+  }
+
+  ten_extension_t *extension =
+      ten_env_get_attached_extension(self->c_ten_env);
+  if (!extension) {
+    return false;
+  }
+
+  ten_app_t *app = ten_extension_get_belonging_app(extension);
+  return app && app->is_standalone_test_app;
+}
+
+int ten_go_ten_env_get_stop_reason(uintptr_t bridge_addr) {
+  ten_go_ten_env_t *self = ten_go_ten_env_reinterpret(bridge_addr);
+  TEN_ASSERT(self, "Should not happen.");
+  TEN_ASSERT(ten_go_ten_env_check_integrity(self), "Should not happen.");
+
+  // 0: normal graph stop, 1: whole app closing. Matches the StopReason
+  // values on the GO side.
+  if (self->c_ten_env->attach_to != TEN_ENV_ATTACH_TO_EXTENSION) {
+    return 0;
+  }
+
+  ten_extension_t *extension =
+      ten_env_get_attached_extension(self->c_ten_env);
+  if (!extension) {
+    return 0;
+  }
+
+  ten_app_t *app = ten_extension_get_belonging_app(extension);
+  if (app) {
+    ten_mutex_lock(app->state_lock);
+    TEN_APP_STATE state = app->state;
+    ten_mutex_unlock(app->state_lock);
+
+    if (state >= TEN_APP_STATE_CLOSING) {
+      return 1;
+    }
+  }
+
+  return 0;
+}
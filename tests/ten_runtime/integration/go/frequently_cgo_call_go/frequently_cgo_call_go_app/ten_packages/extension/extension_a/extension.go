@@ -18,16 +18,75 @@ import (
 	ten "ten_framework/ten_runtime"
 )
 
-const concurrency = 100
+// stressConfig gathers all tunables of this extension in one place. The
+// defaults preserve the historical hardcoded values, so an app shipping an
+// empty property.json behaves exactly as before.
+type stressConfig struct {
+	EnableStressTest bool `json:"enable_stress_test"`
+
+	// BurstCount is the number of round-trips per pool boundary size in the
+	// OnStart stress workload.
+	BurstCount int `json:"burst_count"`
+
+	// Concurrency is the number of goroutines hammering one cmd in OnCmd.
+	Concurrency int `json:"concurrency"`
+}
+
+func defaultStressConfig() stressConfig {
+	return stressConfig{
+		EnableStressTest: true,
+		BurstCount:       100,
+		Concurrency:      100,
+	}
+}
+
+// loadStressConfig decodes the whole property tree in one batch instead of
+// issuing one GetProperty call per field, and rejects invalid values up front
+// so misconfiguration surfaces at load instead of mid-workload.
+func loadStressConfig(tenEnv ten.TenEnv) (stressConfig, error) {
+	cfg := defaultStressConfig()
+
+	raw, err := tenEnv.GetPropertyToJSONBytes("")
+	if err != nil {
+		return cfg, err
+	}
+	defer ten.ReleaseBytes(raw)
+
+	if err := json.Unmarshal(raw, &cfg); err != nil {
+		return cfg, err
+	}
+
+	if cfg.BurstCount <= 0 {
+		return cfg, fmt.Errorf("burst_count must be > 0, got %d", cfg.BurstCount)
+	}
+
+	if cfg.Concurrency <= 0 {
+		return cfg, fmt.Errorf("concurrency must be > 0, got %d", cfg.Concurrency)
+	}
+
+	return cfg, nil
+}
 
 type extensionA struct {
 	ten.DefaultExtension
+
+	cfg stressConfig
 }
 
 func newExtensionA(name string) ten.Extension {
 	return &extensionA{}
 }
 
+func (p *extensionA) OnConfigure(tenEnv ten.TenEnv) {
+	cfg, err := loadStressConfig(tenEnv)
+	if err != nil {
+		panic(fmt.Sprintf("invalid stress config: %v", err))
+	}
+
+	p.cfg = cfg
+	tenEnv.OnConfigureDone()
+}
+
 // makeJSONOfSize creates a JSON object whose serialized form is exactly `size`
 // bytes (the strlen, without '\0'). This targets the pool boundary sizes
 // (128, 512, 1024, 2048, 4096) so that acquireBytes returns a buffer whose
@@ -45,6 +104,11 @@ func makeJSONOfSize(targetSize int) []byte {
 }
 
 func (p *extensionA) OnStart(tenEnv ten.TenEnv) {
+	if !p.cfg.EnableStressTest {
+		tenEnv.OnStartDone()
+		return
+	}
+
 	go func() {
 		// ---------------------------------------------------------------
 		// Repro: stress GetPropertyToJSONBytes at exact pool boundary sizes.
@@ -64,7 +128,7 @@ func (p *extensionA) OnStart(tenEnv ten.TenEnv) {
 		// Pool boundary sizes from bytes.go
 		boundaries := []int{128, 512, 1024, 2048, 4096}
 
-		const iterations = 100
+		iterations := p.cfg.BurstCount
 		var wg sync.WaitGroup
 		var errCount int32
 
@@ -137,6 +201,8 @@ func (p *extensionA) OnCmd(
 	go func() {
 		fmt.Println("extensionA OnCmd")
 
+		concurrency := p.cfg.Concurrency
+
 		cmdB, _ := ten.NewCmd("B")
 		var count uint32 = 0
 		var propLock sync.Mutex
@@ -159,7 +225,7 @@ func (p *extensionA) OnCmd(
 					panic("should not happen")
 				}
 
-				if atomic.AddUint32(&count, 1) == concurrency {
+				if atomic.AddUint32(&count, 1) == uint32(concurrency) {
 					done <- struct{}{}
 				}
 			}(i % 100)